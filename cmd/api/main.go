@@ -2,23 +2,36 @@ package main
 
 import (
 	"context"
+	"net"
 
 	echoPrometheus "github.com/globocom/echo-prometheus"
+	"github.com/go-pg/pg/orm"
 	"github.com/insolar/insconfig"
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/instrumentation/inslogger"
 	"github.com/mitchellh/mapstructure"
+	"github.com/pkg/errors"
 
 	insconf "github.com/insolar/insolar/configuration"
 	"github.com/insolar/insolar/log"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
 
 	"github.com/insolar/observer/configuration"
+	"github.com/insolar/observer/internal/app/api"
+	"github.com/insolar/observer/internal/app/api/auth"
+	"github.com/insolar/observer/internal/app/api/cache"
+	"github.com/insolar/observer/internal/app/api/graph"
 	"github.com/insolar/observer/internal/app/api/handlers"
+	"github.com/insolar/observer/internal/app/api/ratelimit"
+	"github.com/insolar/observer/internal/app/api/rpc"
+	"github.com/insolar/observer/internal/app/observer"
 	"github.com/insolar/observer/internal/app/observer/postgres"
 	"github.com/insolar/observer/internal/dbconn"
+	"github.com/insolar/observer/internal/dbmigrate"
 )
 
 func main() {
@@ -43,10 +56,13 @@ func main() {
 		BufferSize:   cfg.GetLog().Buffer,
 	}
 	_, logger := initGlobalLogger(context.Background(), loggerConfig)
-	db, err := dbconn.Connect(cfg.GetDB())
+	pool, err := dbconn.ConnectPool(cfg.GetDB(), cfg.GetReplicas(), logger)
 	if err != nil {
 		logger.Fatal(err.Error())
 	}
+	schema, readOnly := checkSchema(logger, pool.Primary(), cfg.GetSchemaCheck())
+	pool.Start()
+	prometheus.MustRegister(pool.StatsCollector())
 
 	wa := EchoWriterAdapter{logger: logger}
 	e := echo.New()
@@ -55,13 +71,79 @@ func main() {
 	}))
 	e.Use(echoPrometheus.MetricsMiddleware())
 	e.GET("/metrics", echo.WrapHandler(promhttp.Handler()))
+	e.GET("/healthcheck", api.HealthcheckHandler(schema, readOnly))
+	if readOnly {
+		e.Use(api.ReadOnly(append([]string{graph.Path}, api.BatchPaths...)...))
+	}
+
+	authn, err := auth.New(pool, logger, cfg.GetAuth())
+	if err != nil {
+		logger.Fatal(err.Error())
+	}
+	limiter := ratelimit.New(pool, logger, cfg.GetRateLimit())
+	go limiter.Run(context.Background())
+	prometheus.MustRegister(limiter.Collector())
+	// Routes authenticate on their own, so the global limit comes before any credentials check.
+	e.Use(limiter.LimitIP)
+	responses := cache.New(pool, logger, cfg.GetResponseCache())
+	go responses.Run(context.Background())
+	prometheus.MustRegister(responses.Collector())
+
+	// Streams and exports aren't buffered to compute ETag.
+	e.Use(api.ConditionalGET(pool, "/metrics", api.StreamPath, api.StatementPath))
+	pStorage := postgres.NewPulseStorage(logger, pool.Primary())
+	handlers.RegisterHandlers(e, pool, logger, pStorage, cfg, authn, limiter, responses)
 
-	pStorage := postgres.NewPulseStorage(logger, db)
-	handlers.RegisterHandlers(e, db, logger, pStorage, cfg)
+	if cfg.GetGRPC().Enabled {
+		go serveGRPC(cfg.GetGRPC(), pool, logger, pStorage, authn, limiter, readOnly)
+	}
 
 	e.Logger.Fatal(e.Start(cfg.GetListen()))
 }
 
+// serveGRPC serves the same data as REST, so it requires the public scope and is limited the same way.
+func serveGRPC(
+	cfg configuration.GRPC,
+	pool *dbconn.Pool,
+	logger insolar.Logger,
+	pStorage observer.PulseStorage,
+	authn *auth.Authenticator,
+	limiter *ratelimit.Limiter,
+	readOnly bool,
+) {
+	stream := api.NewStream(pool, logger)
+	go stream.Run(context.Background())
+
+	unary := []grpc.UnaryServerInterceptor{
+		limiter.UnaryIPInterceptor,
+		authn.UnaryInterceptor(auth.ScopePublic),
+		limiter.UnaryInterceptor,
+	}
+	streams := []grpc.StreamServerInterceptor{
+		limiter.StreamIPInterceptor,
+		authn.StreamInterceptor(auth.ScopePublic),
+		limiter.StreamInterceptor,
+	}
+	if readOnly {
+		unary = append([]grpc.UnaryServerInterceptor{rpc.ReadOnly(rpc.ReadMethods...)}, unary...)
+		streams = append([]grpc.StreamServerInterceptor{rpc.ReadOnlyStream(rpc.ReadMethods...)}, streams...)
+	}
+	server := grpc.NewServer(
+		grpc.UnaryInterceptor(rpc.ChainUnary(unary...)),
+		grpc.StreamInterceptor(rpc.ChainStream(streams...)),
+	)
+	rpc.RegisterObserverServer(server, rpc.NewServer(pool, logger, pStorage, stream))
+
+	lis, err := net.Listen("tcp", cfg.Listen)
+	if err != nil {
+		logger.Fatal(errors.Wrap(err, "failed to listen grpc"))
+	}
+	logger.Infof("grpc server started on %s", lis.Addr())
+	if err := server.Serve(lis); err != nil {
+		logger.Fatal(errors.Wrap(err, "grpc server stopped"))
+	}
+}
+
 type EchoWriterAdapter struct {
 	logger insolar.Logger
 }
@@ -71,6 +153,31 @@ func (o *EchoWriterAdapter) Write(p []byte) (n int, err error) {
 	return len(p), nil
 }
 
+// checkSchema compares db schema version with the expected one.
+// On mismatch API refuses to start in strict mode and starts without write endpoints in readonly mode.
+func checkSchema(logger insolar.Logger, db orm.DB, mode string) (dbmigrate.Schema, bool) {
+	schema, err := dbmigrate.CheckSchema(db)
+	if err == nil {
+		logger.Infof("db schema version %d", schema.Version)
+		return schema, false
+	}
+	if errors.Cause(err) != dbmigrate.ErrSchemaMismatch {
+		logger.Fatal(errors.Wrap(err, "refusing to start"))
+	}
+
+	switch mode {
+	case configuration.SchemaCheckReadOnly:
+		logger.Warn(errors.Wrap(err, "starting in read-only mode"))
+		return schema, true
+	case configuration.SchemaCheckOff:
+		logger.Warn(errors.Wrap(err, "schema check is off, starting anyway"))
+		return schema, false
+	default:
+		logger.Fatal(errors.Wrap(err, "refusing to start"))
+	}
+	return schema, false
+}
+
 func initGlobalLogger(ctx context.Context, cfg insconf.Log) (context.Context, insolar.Logger) {
 	inslog, err := log.NewGlobalLogger(cfg)
 	if err != nil {
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/insolar/insconfig"
+	"github.com/mitchellh/mapstructure"
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+
+	"github.com/insolar/observer/configuration"
+	"github.com/insolar/observer/internal/app/api/auth"
+	"github.com/insolar/observer/internal/dbconn"
+)
+
+const usage = "usage: apikey --config=<api config path> " +
+	"create --name=<name> --scopes=public,admin,export [--expires=<duration>] | list | revoke --id=<id>"
+
+var (
+	name    = flag.String("name", "", "key name, used by create command")
+	scopes  = flag.String("scopes", auth.ScopePublic, "comma separated key scopes, used by create command")
+	expires = flag.Duration("expires", 0, "key lifetime, doesn't expire if 0, used by create command")
+	id      = flag.Int64("id", 0, "key id, used by revoke command")
+)
+
+func main() {
+	cfg := configuration.GetAPIConfig()
+	params := insconfig.Params{
+		EnvPrefix:  "observerapi",
+		ViperHooks: []mapstructure.DecodeHookFunc{configuration.ToBigIntHookFunc()},
+		ConfigPathGetter: &insconfig.FlagPathGetter{
+			GoFlags: flag.CommandLine,
+		},
+	}
+	insConfigurator := insconfig.New(params)
+	if err := insConfigurator.Load(cfg); err != nil {
+		panic(err)
+	}
+
+	// insconfig parses command line with pflag, so positional arguments are there.
+	args := pflag.Args()
+	if len(args) != 1 {
+		fail(errors.New(usage))
+	}
+
+	db, err := dbconn.Connect(cfg.GetDB())
+	if err != nil {
+		fail(err)
+	}
+	defer db.Close()
+	ctx := context.Background()
+
+	switch args[0] {
+	case "create":
+		if *name == "" {
+			fail(errors.New("--name is required"))
+		}
+		var until *time.Time
+		if *expires > 0 {
+			t := time.Now().Add(*expires)
+			until = &t
+		}
+		key, apiKey, err := auth.MintKey(ctx, db, *name, strings.Split(*scopes, ","), until)
+		if err != nil {
+			fail(err)
+		}
+		fmt.Fprintf(os.Stderr, "created key %d, it won't be shown again\n", apiKey.ID)
+		fmt.Println(key)
+	case "list":
+		keys, err := auth.ListKeys(ctx, db)
+		if err != nil {
+			fail(err)
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(w, "ID\tNAME\tSCOPES\tCREATED\tEXPIRES\tREVOKED")
+		for _, k := range keys {
+			fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%s\n",
+				k.ID, k.Name, strings.Join(k.Scopes, ","), k.Created.Format(time.RFC3339), formatTime(k.Expires), formatTime(k.Revoked))
+		}
+		_ = w.Flush()
+	case "revoke":
+		if *id == 0 {
+			fail(errors.New("--id is required"))
+		}
+		if err := auth.RevokeKey(ctx, db, *id); err != nil {
+			fail(errors.Wrapf(err, "failed to revoke key %d", *id))
+		}
+		fmt.Fprintf(os.Stderr, "revoked key %d\n", *id)
+	default:
+		fail(errors.Errorf("unknown command %q, %s", args[0], usage))
+	}
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return "-"
+	}
+	return t.Format(time.RFC3339)
+}
+
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, err)
+	os.Exit(1)
+}
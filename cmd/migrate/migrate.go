@@ -3,18 +3,32 @@ package main
 import (
 	"context"
 	"flag"
+	"fmt"
+	"os"
+	"strconv"
 
-	"github.com/go-pg/migrations"
 	"github.com/insolar/insconfig"
 	"github.com/insolar/insolar/instrumentation/inslogger"
 	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
 
 	"github.com/insolar/observer/configuration"
 	"github.com/insolar/observer/internal/dbconn"
+	"github.com/insolar/observer/internal/dbmigrate"
 )
 
 var migrationDir = flag.String("dir", "", "directory with migrations")
 var doInit = flag.Bool("init", false, "perform db init (for empty db)")
+var dryRun = flag.Bool("dry-run", false, "print sql of planned migrations without applying it")
+
+const usage = `usage: migrate --config=<path> --dir=<path> [--init] [--dry-run] [command]
+
+commands:
+  up            apply all pending migrations (default)
+  down          revert the last applied migration
+  to <version>  migrate up or down to the version
+  status        print applied and pending migrations
+`
 
 func main() {
 	cfg := &configuration.Migrate{}
@@ -33,26 +47,78 @@ func main() {
 	ctx := context.Background()
 	log := inslogger.FromContext(ctx)
 
+	// insconfig parses command line with pflag, so positional arguments are there.
+	args := pflag.Args()
+	command := "up"
+	if len(args) > 0 {
+		command = args[0]
+	}
+
+	migrations, err := dbmigrate.Discover(*migrationDir)
+	if err != nil {
+		log.Fatal(errors.Wrap(err, "Failed to read migrations"))
+	}
+
 	db, err := dbconn.Connect(cfg.DB)
 	if err != nil {
 		log.Fatal(err.Error())
 	}
-	migrationCollection := migrations.NewCollection()
+	defer db.Close()
+
+	migrator := dbmigrate.NewMigrator(db, migrations)
+	if *dryRun {
+		migrator.DryRun(os.Stdout)
+	}
+
 	if *doInit {
-		_, _, err := migrationCollection.Run(db, "init")
-		if err != nil {
+		if err := migrator.Init(); err != nil {
 			log.Fatal(errors.Wrap(err, "Could not init migrations"))
 		}
 	}
 
-	err = migrationCollection.DiscoverSQLMigrations(*migrationDir)
-	if err != nil {
-		log.Fatal(errors.Wrap(err, "Failed to read migrations"))
+	var steps []dbmigrate.Step
+	switch command {
+	case "status":
+		statuses, current, err := migrator.Status()
+		if err != nil {
+			log.Fatal(errors.Wrap(err, "Could not get migrations status"))
+		}
+		fmt.Printf("current version: %d, latest: %d\n", current, dbmigrate.Latest(migrations))
+		for _, s := range statuses {
+			state := "pending"
+			if s.Applied {
+				state = "applied"
+			}
+			fmt.Printf("%-8s %s\n", state, s.Migration)
+		}
+		return
+	case "up":
+		steps, err = migrator.Up()
+	case "down":
+		steps, err = migrator.Down()
+	case "to":
+		if len(args) < 2 {
+			log.Fatal("to requires target version, e.g. migrate to 12")
+		}
+		target, perr := strconv.ParseInt(args[1], 10, 64)
+		if perr != nil {
+			log.Fatal(errors.Wrapf(perr, "invalid target version %s", args[1]))
+		}
+		steps, err = migrator.To(target)
+	default:
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
 	}
 
-	_, _, err = migrationCollection.Run(db, "up")
 	if err != nil {
 		log.Fatal(errors.Wrap(err, "Could not migrate"))
 	}
+	if *dryRun {
+		log.Info("dry run, nothing applied")
+		return
+	}
+	for _, s := range steps {
+		log.Infof("%s done", s)
+	}
 	log.Info("migrated successfully!")
 }
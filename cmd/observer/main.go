@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"flag"
 	"os"
 	"os/signal"
 	"syscall"
@@ -10,6 +11,7 @@ import (
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/instrumentation/inslogger"
 	"github.com/insolar/insolar/log"
+	"github.com/spf13/pflag"
 
 	insconf "github.com/insolar/insolar/configuration"
 
@@ -19,11 +21,15 @@ import (
 
 var stop = make(chan os.Signal, 1)
 
+var toPulse = flag.Uint64("to-pulse", 0, "pulse to rollback to, used by rollback command")
+
 func main() {
 	cfg := &configuration.Observer{}
 	params := insconfig.Params{
-		EnvPrefix:        "observer",
-		ConfigPathGetter: &insconfig.DefaultPathGetter{},
+		EnvPrefix: "observer",
+		ConfigPathGetter: &insconfig.FlagPathGetter{
+			GoFlags: flag.CommandLine,
+		},
 	}
 	insConfigurator := insconfig.New(params)
 	if err := insConfigurator.Load(cfg); err != nil {
@@ -39,6 +45,16 @@ func main() {
 		BufferSize:   cfg.Log.Buffer,
 	}
 	ctx, logger := initGlobalLogger(context.Background(), loggerConfig)
+
+	// insconfig parses command line with pflag, so positional arguments are there.
+	if args := pflag.Args(); len(args) > 0 {
+		if args[0] != "rollback" {
+			logger.Fatalf("unknown command %q, usage: observer --config=<path> [rollback --to-pulse=<pulse>]", args[0])
+		}
+		rollback(ctx, cfg, insolar.PulseNumber(*toPulse))
+		return
+	}
+
 	manager := component.Prepare(ctx, cfg)
 	manager.Start()
 	graceful(logger, manager.Stop)
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"context"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/pkg/errors"
+
+	"github.com/insolar/observer/component"
+	"github.com/insolar/observer/configuration"
+	"github.com/insolar/observer/internal/dbconn"
+	"github.com/insolar/observer/observability"
+)
+
+// rollback rewinds db to the pulse, observer started afterwards collects data from it again.
+func rollback(ctx context.Context, cfg *configuration.Observer, to insolar.PulseNumber) {
+	obs := observability.Make(ctx)
+	logger := obs.Log()
+	if to == 0 {
+		logger.Fatal("--to-pulse is required for rollback")
+	}
+
+	db, err := dbconn.Connect(cfg.DB)
+	if err != nil {
+		logger.Fatal(err.Error())
+	}
+	defer db.Close()
+
+	component.MustCheckSchema(cfg, obs, db)
+
+	if err := component.Rollback(ctx, obs, db, to); err != nil {
+		logger.Fatal(errors.Wrapf(err, "failed to rollback to pulse %d", to))
+	}
+	logger.Infof("rolled back to pulse %d", to)
+}
@@ -46,6 +46,7 @@ func main() {
 
 	calcSupply(logger, db)
 	calcNetwork(logger, db)
+	calcHolders(logger, db)
 }
 
 func calcSupply(log insolar.Logger, db *pg.DB) {
@@ -80,6 +81,22 @@ func calcNetwork(log insolar.Logger, db *pg.DB) {
 	}
 }
 
+func calcHolders(log insolar.Logger, db *pg.DB) {
+	repo := postgres.NewHoldersStatsRepository(db)
+
+	stats, err := repo.CountStats()
+	if err != nil {
+		log.Fatal(errors.Wrapf(err, "failed to count holders stats"))
+	}
+
+	log.Debugf("collected holders stats: %+v", stats.Distribution)
+
+	err = repo.InsertStats(stats)
+	if err != nil {
+		log.Fatal(errors.Wrapf(err, "failed to save holders stats"))
+	}
+}
+
 func initGlobalLogger(ctx context.Context, cfg insconf.Log) (context.Context, insolar.Logger) {
 	inslog, err := log.NewGlobalLogger(cfg)
 	if err != nil {
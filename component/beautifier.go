@@ -84,6 +84,10 @@ func makeBeautifier(
 			}
 		}
 
+		if r.pulse != nil {
+			r.pulse.Records, r.pulse.Requests = len(r.batch), len(requests)
+		}
+
 		// writing to pg
 		tempTimer := time.Now()
 		err := permanentStore.SetRequestBatch(ctx, requests)
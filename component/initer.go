@@ -8,6 +8,7 @@ import (
 	"github.com/insolar/observer/configuration"
 	"github.com/insolar/observer/internal/app/observer/postgres"
 	"github.com/insolar/observer/internal/app/observer/store"
+	"github.com/insolar/observer/internal/dbmigrate"
 	"github.com/insolar/observer/observability"
 )
 
@@ -26,6 +27,23 @@ func makeInitter(cfg *configuration.Observer, obs *observability.Observability,
 	}
 }
 
+// MustCheckSchema compares db schema version with the one observer is built for.
+// Mismatch is fatal unless the check is turned off in config.
+func MustCheckSchema(cfg *configuration.Observer, obs *observability.Observability, db orm.DB) dbmigrate.Schema {
+	logger := obs.Log()
+	schema, err := dbmigrate.CheckSchema(db)
+	if err == nil {
+		logger.Infof("db schema version %d", schema.Version)
+		return schema
+	}
+	if errors.Cause(err) == dbmigrate.ErrSchemaMismatch && cfg.SchemaCheck == configuration.SchemaCheckOff {
+		logger.Warn(errors.Wrap(err, "schema check is off, starting anyway"))
+		return schema
+	}
+	logger.Fatal(errors.Wrap(err, "refusing to start"))
+	return schema
+}
+
 func MustKnowPulse(obs *observability.Observability, db orm.DB) insolar.PulseNumber {
 	pulses := postgres.NewPulseStorage(obs.Log(), db)
 	p, err := pulses.Last()
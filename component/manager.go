@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/go-pg/pg"
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/ledger/heavy/exporter"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/insolar/observer/connectivity"
 	"github.com/insolar/observer/internal/app/observer"
 	"github.com/insolar/observer/internal/app/observer/grpc"
+	"github.com/insolar/observer/internal/dbconn"
 	"github.com/insolar/observer/observability"
 )
 
@@ -34,7 +36,9 @@ type Manager struct {
 func Prepare(ctx context.Context, cfg *configuration.Observer) *Manager {
 	obs := observability.Make(ctx)
 	conn := connectivity.Make(cfg, obs)
-	router := NewRouter(cfg, obs)
+	schema := MustCheckSchema(cfg, obs, conn.PG())
+	obs.Metrics().MustRegister(dbconn.NewStatsCollector(map[string]*pg.DB{"primary": conn.PG()}))
+	router := NewRouter(cfg, obs, schema)
 	pulses := grpc.NewPulseFetcher(cfg, obs, exporter.NewPulseExporterClient(conn.GRPC()))
 	records := grpc.NewRecordFetcher(cfg, obs, exporter.NewRecordExporterClient(conn.GRPC()))
 	sm := NewSleepManager(cfg)
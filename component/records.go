@@ -0,0 +1,113 @@
+package component
+
+import (
+	"context"
+
+	gopg "github.com/go-pg/pg"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/pkg/errors"
+
+	"github.com/insolar/observer/internal/app/observer/postgres"
+	"github.com/insolar/observer/internal/app/observer/store/pg"
+)
+
+// RawRecord is a stored request with its result and side effect, any of them may be missing.
+type RawRecord struct {
+	Request    *record.Material
+	Result     *record.Material
+	SideEffect *record.Material
+}
+
+// GetRawRecord fetches the request with the id, or the request of the side effect with the id,
+// together with the result and the side effect of the request. Results are stored by request ids only.
+func GetRawRecord(ctx context.Context, db Querier, id insolar.ID) (*RawRecord, error) {
+	res := &RawRecord{}
+	requestID := id.String()
+
+	request := pg.RawRequest{}
+	found, err := queryRaw(ctx, db, &request, `select * from raw_requests where request_id = ?`, requestID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch request")
+	}
+	sideEffect := pg.RawSideEffect{}
+	if !found {
+		ok, err := queryRaw(ctx, db, &sideEffect, `select * from raw_side_effects where id = ?`, requestID)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to fetch side effect")
+		}
+		if !ok {
+			return nil, ErrRecordNotFound
+		}
+		requestID = sideEffect.RequestID
+		found, err = queryRaw(ctx, db, &request, `select * from raw_requests where request_id = ?`, requestID)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to fetch request")
+		}
+	} else {
+		_, err = queryRaw(ctx, db, &sideEffect, `select * from raw_side_effects where request_id = ?`, requestID)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to fetch side effect")
+		}
+	}
+	result := pg.RawResult{}
+	_, err = queryRaw(ctx, db, &result, `select * from raw_results where request_id = ?`, requestID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch result")
+	}
+
+	if found {
+		if res.Request, err = unmarshalMaterial(request.Body); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal request body")
+		}
+	}
+	if result.Body != nil {
+		if res.Result, err = unmarshalMaterial(result.Body); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal result body")
+		}
+	}
+	if sideEffect.Body != nil {
+		if res.SideEffect, err = unmarshalMaterial(sideEffect.Body); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal side effect body")
+		}
+	}
+	return res, nil
+}
+
+// GetObjectStates fetches up to `limit` states of the object from its activation, each next state refers
+// to the previous one by prev_state. The object reference is the reference of the request that activated it.
+func GetObjectStates(ctx context.Context, db Querier, ref insolar.Reference, limit int) ([]postgres.ObjectSchema, error) {
+	var states []postgres.ObjectSchema
+	_, err := db.QueryContext(ctx, &states, `
+		with recursive chain as (
+			(select o.*, 1 as n from objects o where o.type = 'ACTIVATE' and o.request in (?0) limit 1)
+			union all
+			select o.*, c.n + 1 from objects o join chain c on o.prev_state = c.object_id || '.record'
+			where c.n < ?1
+		)
+		select object_id, domain, request, memory, image, parent, prev_state, type from chain order by n`,
+		gopg.In([]string{ref.String(), insolar.NewRecordReference(*ref.GetLocal()).String()}), limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch object states")
+	}
+	if len(states) == 0 {
+		return nil, ErrObjectNotFound
+	}
+	return states, nil
+}
+
+func queryRaw(ctx context.Context, db Querier, model interface{}, query string, id string) (bool, error) {
+	_, err := db.QueryOneContext(ctx, model, query, id)
+	if err == gopg.ErrNoRows {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func unmarshalMaterial(body []byte) (*record.Material, error) {
+	mat := &record.Material{}
+	if err := mat.Unmarshal(body); err != nil {
+		return nil, err
+	}
+	return mat, nil
+}
@@ -0,0 +1,367 @@
+package component
+
+import (
+	"context"
+
+	gopg "github.com/go-pg/pg"
+	"github.com/go-pg/pg/orm"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/mainnet/application/builtin/contract/account"
+	"github.com/insolar/mainnet/application/builtin/contract/deposit"
+	"github.com/pkg/errors"
+
+	"github.com/insolar/observer/internal/app/observer/store/pg"
+	"github.com/insolar/observer/internal/models"
+	"github.com/insolar/observer/observability"
+)
+
+// Rollback removes everything observer derived from pulses after `to`.
+// States of members, deposits and burned balances are restored from amend records in raw_side_effects,
+// rows created after `to` are deleted. Transactions sent by results after `to` are not sent anymore.
+// Observer started afterwards continues from `to`.
+// Observer must be stopped while rollback is running.
+func Rollback(ctx context.Context, obs *observability.Observability, db *gopg.DB, to insolar.PulseNumber) error {
+	log := obs.Log()
+
+	return db.RunInTransaction(func(tx *gopg.Tx) error {
+		n, err := tx.Model(&models.Pulse{}).Where("pulse = ?", to).Count()
+		if err != nil {
+			return errors.Wrap(err, "failed to check pulse")
+		}
+		if n == 0 {
+			return errors.Errorf("pulse %d is not stored, nothing to rollback to", to)
+		}
+
+		r := &rollback{ctx: ctx, log: log, tx: tx, to: to}
+		if err := r.members(); err != nil {
+			return errors.Wrap(err, "failed to rollback members")
+		}
+		if err := r.deposits(); err != nil {
+			return errors.Wrap(err, "failed to rollback deposits")
+		}
+		if err := r.burnedBalances(); err != nil {
+			return errors.Wrap(err, "failed to rollback burned balances")
+		}
+		if err := r.migrationAddresses(); err != nil {
+			return errors.Wrap(err, "failed to rollback migration addresses")
+		}
+		if err := r.transactions(); err != nil {
+			return errors.Wrap(err, "failed to rollback transactions")
+		}
+		if err := r.laterResults(); err != nil {
+			return errors.Wrap(err, "failed to rollback results")
+		}
+		// Raw records go last, restoring states above reads them.
+		if err := r.records(); err != nil {
+			return errors.Wrap(err, "failed to rollback records")
+		}
+		return nil
+	})
+}
+
+// rollbackBatch is the number of raw results decoded at once.
+const rollbackBatch = 1000
+
+type rollback struct {
+	ctx context.Context
+	log insolar.Logger
+	tx  *gopg.Tx
+	to  insolar.PulseNumber
+
+	// Migration addresses of deleted members, they are not wasted anymore.
+	freedAddresses []string
+}
+
+type stateRow struct {
+	Ref   []byte `sql:"ref"`
+	State []byte `sql:"state"`
+}
+
+func (r *rollback) exec(what string, query string, params ...interface{}) error {
+	res, err := r.tx.Exec(query, params...)
+	if err != nil {
+		return errors.Wrapf(err, "failed to %s", what)
+	}
+	r.log.Infof("rollback: %s, affected %d", what, res.RowsAffected())
+	return nil
+}
+
+func (r *rollback) members() error {
+	var rows []stateRow
+	_, err := r.tx.Query(&rows, `
+		SELECT member_ref AS ref, account_state AS state FROM members
+		WHERE bytea_pulse(member_ref) <= ?0 AND bytea_pulse(account_state) > ?0`, r.to)
+	if err != nil {
+		return errors.Wrap(err, "failed to select members")
+	}
+
+	var deleted [][]byte
+	for _, row := range rows {
+		mat, id, ok, err := r.stateAt(*insolar.NewIDFromBytes(row.State))
+		if err != nil {
+			return err
+		}
+		if !ok {
+			deleted = append(deleted, row.Ref)
+			continue
+		}
+		acc := account.Account{}
+		if err := insolar.Deserialize(stateMemory(mat), &acc); err != nil {
+			return errors.Wrapf(err, "failed to deserialize account state %s", id.String())
+		}
+		_, err = r.tx.Exec(`UPDATE members SET balance = ?, account_state = ? WHERE member_ref = ?`,
+			acc.Balance, id.Bytes(), row.Ref)
+		if err != nil {
+			return errors.Wrap(err, "failed to restore member balance")
+		}
+	}
+	r.log.Infof("rollback: restore member balances, affected %d", len(rows)-len(deleted))
+
+	_, err = r.tx.Query(&r.freedAddresses, `
+		SELECT migration_address FROM members
+		WHERE migration_address IS NOT NULL AND (bytea_pulse(member_ref) > ? OR member_ref IN (?))`,
+		r.to, gopg.In(append(deleted, []byte{})))
+	if err != nil {
+		return errors.Wrap(err, "failed to select migration addresses of members")
+	}
+
+	err = r.exec("delete augmented addresses of members",
+		`DELETE FROM augmented_addresses WHERE bytea_pulse(member_ref) > ? OR member_ref IN (?)`,
+		r.to, gopg.In(append(deleted, []byte{})))
+	if err != nil {
+		return err
+	}
+	return r.exec("delete members",
+		`DELETE FROM members WHERE bytea_pulse(member_ref) > ? OR member_ref IN (?)`,
+		r.to, gopg.In(append(deleted, []byte{})))
+}
+
+func (r *rollback) deposits() error {
+	var rows []stateRow
+	_, err := r.tx.Query(&rows, `
+		SELECT deposit_ref AS ref, deposit_state AS state FROM deposits
+		WHERE bytea_pulse(deposit_ref) <= ?0 AND bytea_pulse(deposit_state) > ?0`, r.to)
+	if err != nil {
+		return errors.Wrap(err, "failed to select deposits")
+	}
+
+	var deleted [][]byte
+	for _, row := range rows {
+		mat, id, ok, err := r.stateAt(*insolar.NewIDFromBytes(row.State))
+		if err != nil {
+			return err
+		}
+		if !ok {
+			deleted = append(deleted, row.Ref)
+			continue
+		}
+		d := deposit.Deposit{}
+		if err := insolar.Deserialize(stateMemory(mat), &d); err != nil {
+			return errors.Wrapf(err, "failed to deserialize deposit state %s", id.String())
+		}
+
+		status := models.DepositStatusCreated
+		if d.IsConfirmed {
+			status = models.DepositStatusConfirmed
+		}
+		var holdReleaseDate int64
+		if d.PulseDepositUnHold > 0 {
+			hrd, err := d.PulseDepositUnHold.AsApproximateTime()
+			if err == nil {
+				holdReleaseDate = hrd.Unix()
+			}
+		}
+		_, err = r.tx.Exec(`
+			UPDATE deposits SET amount = ?, balance = ?, deposit_state = ?, hold_release_date = ?, status = ?
+			WHERE deposit_ref = ?`,
+			d.Amount, d.Balance, id.Bytes(), holdReleaseDate, status, row.Ref)
+		if err != nil {
+			return errors.Wrap(err, "failed to restore deposit")
+		}
+	}
+	r.log.Infof("rollback: restore deposits, affected %d", len(rows)-len(deleted))
+
+	return r.exec("delete deposits",
+		`DELETE FROM deposits WHERE bytea_pulse(deposit_ref) > ? OR deposit_ref IN (?)`,
+		r.to, gopg.In(append(deleted, []byte{})))
+}
+
+func (r *rollback) burnedBalances() error {
+	var rows []struct {
+		ID    int64  `sql:"id"`
+		State []byte `sql:"account_state"`
+	}
+	_, err := r.tx.Query(&rows, `SELECT id, account_state FROM burned_balance WHERE bytea_pulse(account_state) > ?`, r.to)
+	if err != nil {
+		return errors.Wrap(err, "failed to select burned balances")
+	}
+
+	var deleted []int64
+	for _, row := range rows {
+		mat, id, ok, err := r.stateAt(*insolar.NewIDFromBytes(row.State))
+		if err != nil {
+			return err
+		}
+		if !ok {
+			deleted = append(deleted, row.ID)
+			continue
+		}
+		acc := account.Account{}
+		if err := insolar.Deserialize(stateMemory(mat), &acc); err != nil {
+			return errors.Wrapf(err, "failed to deserialize burned balance state %s", id.String())
+		}
+		_, err = r.tx.Exec(`UPDATE burned_balance SET balance = ?, account_state = ? WHERE id = ?`,
+			acc.Balance, id.Bytes(), row.ID)
+		if err != nil {
+			return errors.Wrap(err, "failed to restore burned balance")
+		}
+	}
+	r.log.Infof("rollback: restore burned balances, affected %d", len(rows)-len(deleted))
+
+	return r.exec("delete burned balances",
+		`DELETE FROM burned_balance WHERE id IN (?)`, gopg.In(append(deleted, 0)))
+}
+
+func (r *rollback) migrationAddresses() error {
+	pulseTime, err := r.to.AsApproximateTime()
+	if err != nil {
+		return errors.Wrap(err, "failed to convert pulse to time")
+	}
+	// Timestamp of an address is the time of the pulse it was added in.
+	err = r.exec("delete migration addresses",
+		`DELETE FROM migration_addresses WHERE timestamp > ?`, pulseTime.Unix())
+	if err != nil {
+		return err
+	}
+	return r.exec("free migration addresses of deleted members",
+		`UPDATE migration_addresses SET wasted = false WHERE addr IN (?)`, gopg.In(append(r.freedAddresses, "")))
+}
+
+func (r *rollback) transactions() error {
+	err := r.exec("delete transactions",
+		`DELETE FROM simple_transactions WHERE pulse_record[1] > ?`, r.to)
+	if err != nil {
+		return err
+	}
+	return r.exec("reset finished transactions", `
+		UPDATE simple_transactions
+		SET status_finished = false, finish_success = false, finish_pulse_record = NULL
+		WHERE finish_pulse_record[1] > ?`, r.to)
+}
+
+// laterResults deletes results stored after r.to for requests stored before it and resets sent status
+// of their transactions. Raw results are keyed by request ids, ids of results are only in their bodies.
+func (r *rollback) laterResults() error {
+	var (
+		last    string
+		deleted []string
+		sent    [][]byte
+	)
+	for {
+		var rows []pg.RawResult
+		_, err := r.tx.Query(&rows, `
+			SELECT * FROM raw_results WHERE request_id > ? AND string_pulse(request_id) <= ?
+			ORDER BY request_id LIMIT ?`, last, r.to, rollbackBatch)
+		if err != nil {
+			return errors.Wrap(err, "failed to select raw results")
+		}
+		for _, row := range rows {
+			mat, err := unmarshalMaterial(row.Body)
+			if err != nil {
+				return errors.Wrapf(err, "failed to unmarshal result of request %s", row.RequestID)
+			}
+			if mat.ID.Pulse() <= r.to {
+				continue
+			}
+			requestID, err := insolar.NewIDFromString(row.RequestID)
+			if err != nil {
+				return errors.Wrapf(err, "wrong request id %s", row.RequestID)
+			}
+			deleted = append(deleted, row.RequestID)
+			sent = append(sent, insolar.NewRecordReference(*requestID).Bytes())
+		}
+		if len(rows) < rollbackBatch {
+			break
+		}
+		last = rows[len(rows)-1].RequestID
+	}
+
+	// Transaction ids are references of their requests, results make them sent.
+	err := r.exec("reset sent transactions",
+		`UPDATE simple_transactions SET status_sent = false, fee = NULL WHERE tx_id IN (?)`,
+		gopg.In(append(sent, []byte{})))
+	if err != nil {
+		return err
+	}
+	return r.exec("delete later raw results",
+		`DELETE FROM raw_results WHERE request_id IN (?)`, gopg.In(append(deleted, "")))
+}
+
+func (r *rollback) records() error {
+	queries := []struct {
+		what  string
+		query string
+	}{
+		{"delete raw requests", `DELETE FROM raw_requests WHERE string_pulse(request_id) > ?`},
+		{"delete raw results", `DELETE FROM raw_results WHERE string_pulse(request_id) > ?`},
+		{"delete raw side effects", `DELETE FROM raw_side_effects WHERE string_pulse(id) > ?`},
+		{"delete objects", `DELETE FROM objects WHERE string_pulse(object_id) > ?`},
+		{"delete requests", `DELETE FROM requests WHERE string_pulse(request_id) > ?`},
+		{"delete results", `DELETE FROM results WHERE string_pulse(result_id) > ?`},
+		{"delete pulse nodes", `DELETE FROM pulse_nodes WHERE pulse > ?`},
+		{"delete pulses", `DELETE FROM pulses WHERE pulse > ?`},
+	}
+	for _, q := range queries {
+		if err := r.exec(q.what, q.query, r.to); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// stateAt walks back the amend chain from state and returns the last state known at r.to.
+// ok is false if the object was activated after r.to.
+func (r *rollback) stateAt(state insolar.ID) (mat record.Material, id insolar.ID, ok bool, err error) {
+	id = state
+	for {
+		mat, err = sideEffect(r.ctx, r.tx, id)
+		if err != nil {
+			return mat, id, false, err
+		}
+		if id.Pulse() <= r.to {
+			return mat, id, true, nil
+		}
+		amd := mat.Virtual.GetAmend()
+		if amd == nil {
+			return mat, id, false, nil
+		}
+		id = amd.PrevState
+	}
+}
+
+func sideEffect(ctx context.Context, db orm.DB, id insolar.ID) (record.Material, error) {
+	mat := record.Material{}
+	raw := pg.RawSideEffect{}
+	_, err := db.QueryOneContext(ctx, &raw, "SELECT * FROM raw_side_effects WHERE id = ?", id.String())
+	if err != nil {
+		if err == gopg.ErrNoRows {
+			return mat, errors.Errorf("side effect %s is not stored, can't restore state", id.String())
+		}
+		return mat, errors.Wrapf(err, "failed to fetch side effect %s", id.String())
+	}
+	if err := mat.Unmarshal(raw.Body); err != nil {
+		return mat, errors.Wrapf(err, "failed to unmarshal side effect %s", id.String())
+	}
+	return mat, nil
+}
+
+func stateMemory(mat record.Material) []byte {
+	switch v := mat.Virtual.Union.(type) {
+	case *record.Virtual_Activate:
+		return v.Activate.Memory
+	case *record.Virtual_Amend:
+		return v.Amend.Memory
+	}
+	return nil
+}
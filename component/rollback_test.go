@@ -0,0 +1,151 @@
+package component
+
+import (
+	"context"
+	"testing"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/mainnet/application/builtin/contract/account"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/observer/internal/app/observer/store/pg"
+	"github.com/insolar/observer/internal/models"
+	"github.com/insolar/observer/observability"
+)
+
+func TestRollback(t *testing.T) {
+	ctx := context.Background()
+	// Pulses far ahead of the ones other tests use, rollback deletes everything after `to`.
+	to := insolar.PulseNumber(1073741000)
+	next := to + 10
+
+	storeSideEffect := func(id insolar.ID, virtual record.Virtual) {
+		mat := record.Material{ID: id, Virtual: virtual}
+		body, err := mat.Marshal()
+		require.NoError(t, err)
+		_, err = db.Model(&pg.RawSideEffect{ID: id.String(), RequestID: gen.ID().String(), Body: body}).Insert()
+		require.NoError(t, err)
+	}
+	memory := func(balance string) []byte {
+		mem, err := insolar.Serialize(&account.Account{Balance: balance})
+		require.NoError(t, err)
+		return mem
+	}
+
+	for _, pn := range []insolar.PulseNumber{to, next} {
+		_, err := db.Model(&models.Pulse{Pulse: uint32(pn)}).Insert()
+		require.NoError(t, err)
+		_, err = db.Model(&models.PulseNode{Pulse: uint32(pn), Reference: gen.Reference().Bytes(), Role: "virtual"}).Insert()
+		require.NoError(t, err)
+	}
+
+	// Member created before `to` with balance changed after it.
+	oldState := gen.IDWithPulse(to)
+	newState := gen.IDWithPulse(next)
+	storeSideEffect(oldState, record.Virtual{Union: &record.Virtual_Activate{
+		Activate: &record.Activate{Memory: memory("100")},
+	}})
+	storeSideEffect(newState, record.Virtual{Union: &record.Virtual_Amend{
+		Amend: &record.Amend{Memory: memory("50"), PrevState: oldState},
+	}})
+	oldMember := models.Member{
+		Reference:    gen.ReferenceWithPulse(to).Bytes(),
+		AccountState: newState.Bytes(),
+		Balance:      "50",
+		PublicKey:    gen.ID().String(),
+	}
+	_, err := db.Model(&oldMember).Insert()
+	require.NoError(t, err)
+
+	// Member created after `to`, its migration address must be freed.
+	address := models.MigrationAddress{Addr: "rollback_test_address", Wasted: true}
+	_, err = db.Model(&address).Insert()
+	require.NoError(t, err)
+	newMember := models.Member{
+		Reference:        gen.ReferenceWithPulse(next).Bytes(),
+		AccountState:     gen.IDWithPulse(next).Bytes(),
+		MigrationAddress: address.Addr,
+		Balance:          "0",
+		PublicKey:        gen.ID().String(),
+	}
+	_, err = db.Model(&newMember).Insert()
+	require.NoError(t, err)
+
+	tx := models.Transaction{
+		TransactionID: gen.RecordReference().Bytes(),
+		Type:          models.TTypeTransfer,
+		PulseRecord:   [2]int64{int64(next), 1},
+	}
+	_, err = db.Model(&tx).Insert()
+	require.NoError(t, err)
+
+	// Transfer requested before `to`, its result came after it.
+	storeResult := func(requestID, resultID insolar.ID) {
+		mat := record.Material{ID: resultID, Virtual: record.Wrap(&record.Result{Request: *insolar.NewRecordReference(requestID)})}
+		body, err := mat.Marshal()
+		require.NoError(t, err)
+		_, err = db.Model(&pg.RawResult{RequestID: requestID.String(), Body: body}).Insert()
+		require.NoError(t, err)
+	}
+	sentRequest, keptRequest := gen.IDWithPulse(to), gen.IDWithPulse(to)
+	storeResult(sentRequest, gen.IDWithPulse(next))
+	storeResult(keptRequest, gen.IDWithPulse(to))
+	sentTx := models.Transaction{
+		TransactionID: insolar.NewRecordReference(sentRequest).Bytes(),
+		Type:          models.TTypeTransfer,
+		PulseRecord:   [2]int64{int64(to), 2},
+		StatusSent:    true,
+		Fee:           "1",
+	}
+	_, err = db.Model(&sentTx).Insert()
+	require.NoError(t, err)
+
+	err = Rollback(ctx, observability.Make(ctx), db, to)
+	require.NoError(t, err)
+
+	member := models.Member{}
+	err = db.Model(&member).Where("member_ref = ?", oldMember.Reference).Select()
+	require.NoError(t, err)
+	require.Equal(t, "100", member.Balance)
+	require.Equal(t, oldState.Bytes(), member.AccountState)
+
+	n, err := db.Model(&models.Member{}).Where("member_ref = ?", newMember.Reference).Count()
+	require.NoError(t, err)
+	require.Equal(t, 0, n)
+
+	freed := models.MigrationAddress{}
+	err = db.Model(&freed).Where("addr = ?", address.Addr).Select()
+	require.NoError(t, err)
+	require.False(t, freed.Wasted)
+
+	n, err = db.Model(&models.Transaction{}).Where("tx_id = ?", tx.TransactionID).Count()
+	require.NoError(t, err)
+	require.Equal(t, 0, n)
+
+	n, err = db.Model(&models.Pulse{}).Where("pulse > ?", to).Count()
+	require.NoError(t, err)
+	require.Equal(t, 0, n)
+
+	n, err = db.Model(&models.PulseNode{}).Where("pulse > ?", to).Count()
+	require.NoError(t, err)
+	require.Equal(t, 0, n)
+
+	n, err = db.Model(&pg.RawSideEffect{}).Where("id = ?", newState.String()).Count()
+	require.NoError(t, err)
+	require.Equal(t, 0, n)
+
+	n, err = db.Model(&pg.RawResult{}).Where("request_id = ?", sentRequest.String()).Count()
+	require.NoError(t, err)
+	require.Equal(t, 0, n)
+	n, err = db.Model(&pg.RawResult{}).Where("request_id = ?", keptRequest.String()).Count()
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+
+	sent := models.Transaction{}
+	err = db.Model(&sent).Where("tx_id = ?", sentTx.TransactionID).Select()
+	require.NoError(t, err)
+	require.False(t, sent.StatusSent)
+	require.Empty(t, sent.Fee)
+}
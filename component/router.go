@@ -2,6 +2,7 @@ package component
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/pprof"
@@ -11,10 +12,16 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 
 	"github.com/insolar/observer/configuration"
+	"github.com/insolar/observer/internal/dbmigrate"
 	"github.com/insolar/observer/observability"
 )
 
-func NewRouter(cfg *configuration.Observer, obs *observability.Observability) *Router {
+type healthcheck struct {
+	Status string `json:"status"`
+	dbmigrate.Schema
+}
+
+func NewRouter(cfg *configuration.Observer, obs *observability.Observability, schema dbmigrate.Schema) *Router {
 	mux := http.NewServeMux()
 
 	mux.HandleFunc("/healthcheck", func(w http.ResponseWriter, _ *http.Request) {
@@ -22,6 +29,12 @@ func NewRouter(cfg *configuration.Observer, obs *observability.Observability) *R
 		w.WriteHeader(http.StatusOK)
 		_, _ = fmt.Fprint(w, "OK")
 	})
+	// Monitoring expects the plain text healthcheck, so the schema version is reported separately.
+	mux.HandleFunc("/healthcheck/schema", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(healthcheck{Status: "OK", Schema: schema})
+	})
 	mux.HandleFunc("/metrics", func(w http.ResponseWriter, req *http.Request) {
 		ops := promhttp.HandlerOpts{
 			ErrorLog: PromHTTPLoggerAdapter{obs.Log()},
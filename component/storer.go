@@ -3,7 +3,9 @@ package component
 import (
 	"context"
 	"fmt"
+	"math/big"
 	"strings"
+	"time"
 
 	"github.com/go-pg/pg"
 	"github.com/go-pg/pg/orm"
@@ -633,12 +635,39 @@ var (
 	ErrTxNotFound           = errors.New("tx not found")
 	ErrReferenceNotFound    = errors.New("Reference not found")
 	ErrNotificationNotFound = errors.New("Notification not found")
+	ErrPulseNotFound        = errors.New("pulse not found")
+	ErrRecordNotFound       = errors.New("record not found")
+	ErrObjectNotFound       = errors.New("object not found")
 )
 
 func GetMemberBalance(ctx context.Context, db Querier, reference []byte) (*models.Member, error) {
 	return getMember(ctx, db, reference, []string{"balance"})
 }
 
+// GetMemberBalanceChange returns how much successful transactions registered in pulses (from, to] changed member account balance.
+// Sender pays amount and fee, receiver gets amount unless it goes to a deposit.
+func GetMemberBalanceChange(ctx context.Context, db Querier, reference []byte, from, to int64) (*big.Int, error) {
+	var change string
+	_, err := db.QueryOneContext(ctx, pg.Scan(&change), `
+		select coalesce(sum(
+			case when member_to_ref = ?0 and deposit_to_ref is null then coalesce(nullif(amount, ''), '0')::numeric else 0 end -
+			case when member_from_ref = ?0 then coalesce(nullif(amount, ''), '0')::numeric + coalesce(nullif(fee, ''), '0')::numeric else 0 end
+		), 0)::text
+		from simple_transactions
+		where (member_from_ref = ?0 or member_to_ref = ?0)
+			and status_registered and status_finished and finish_success
+			and pulse_record[1] > ?1 and pulse_record[1] <= ?2`,
+		reference, from, to)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to sum member transactions")
+	}
+	res, ok := new(big.Int).SetString(change, 10)
+	if !ok {
+		return nil, errors.Errorf("invalid balance change %q", change)
+	}
+	return res, nil
+}
+
 func GetMember(ctx context.Context, db Querier, reference []byte) (*models.Member, error) {
 	return getMember(ctx, db, reference, models.Member{}.Fields())
 }
@@ -754,6 +783,244 @@ func GetTx(ctx context.Context, db Querier, txID []byte) (*models.Transaction, e
 	return tx, nil
 }
 
+// GetMembersBy fetches members whose column equals any of the values with one query.
+// Column is member_ref, migration_address or public_key, values is a slice of the column type.
+func GetMembersBy(ctx context.Context, db Querier, column string, values interface{}) ([]models.Member, error) {
+	switch column {
+	case "member_ref", "migration_address", "public_key":
+	default:
+		return nil, errors.Errorf("can't fetch members by %s", column)
+	}
+	var members []models.Member
+	_, err := db.QueryContext(ctx, &members,
+		fmt.Sprintf( // nolint: gosec
+			`select %s from members where %s = ANY(?0)`, strings.Join(models.Member{}.Fields(), ","), column),
+		pg.Array(values))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch members")
+	}
+	return members, nil
+}
+
+// GetMembersDeposits is GetDeposits for several members with one query.
+func GetMembersDeposits(ctx context.Context, db Querier, memberReferences [][]byte, onlyConfirmed bool) ([]models.Deposit, error) {
+	deposits := make([]models.Deposit, 0)
+	whereCond := []string{"member_ref = ANY(?0)"}
+	if onlyConfirmed {
+		whereCond = append(whereCond, "status = 'confirmed'")
+	}
+	_, err := db.QueryContext(ctx, &deposits,
+		fmt.Sprintf( // nolint: gosec
+			`select %s from deposits where %s order by deposit_number`, strings.Join(models.Deposit{}.Fields(), ","), strings.Join(whereCond, " AND ")),
+		pg.Array(memberReferences))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch deposits")
+	}
+	return deposits, nil
+}
+
+// GetDeposit fetches deposit in any status, ErrReferenceNotFound is returned if there is no such deposit.
+func GetDeposit(ctx context.Context, db Querier, reference []byte) (*models.Deposit, error) {
+	deposit := &models.Deposit{}
+	_, err := db.QueryOneContext(ctx, deposit,
+		fmt.Sprintf( // nolint: gosec
+			`select %s from deposits where deposit_ref = ?0`, strings.Join(deposit.Fields(), ",")),
+		reference)
+	if err != nil {
+		if err == pg.ErrNoRows {
+			return nil, ErrReferenceNotFound
+		}
+		return nil, errors.Wrap(err, "failed to fetch deposit")
+	}
+	return deposit, nil
+}
+
+// GetDepositsByEthHash fetches deposits created by the migration with the ethereum transaction hash.
+func GetDepositsByEthHash(ctx context.Context, db Querier, ethHash string) ([]models.Deposit, error) {
+	deposits := make([]models.Deposit, 0)
+	_, err := db.QueryContext(ctx, &deposits,
+		fmt.Sprintf( // nolint: gosec
+			`select %s from deposits where eth_hash = ?0 order by deposit_number`, strings.Join(models.Deposit{}.Fields(), ",")),
+		ethHash)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch deposits")
+	}
+	return deposits, nil
+}
+
+// GetDepositsTxs fetches registered migrations to and releases from the deposits in chronological order.
+func GetDepositsTxs(ctx context.Context, db Querier, depositReferences [][]byte) ([]models.Transaction, error) {
+	var txs []models.Transaction
+	_, err := db.QueryContext(ctx, &txs,
+		fmt.Sprintf( // nolint: gosec
+			`select %s from simple_transactions where (deposit_to_ref = ANY(?0) or deposit_from_ref = ANY(?0)) and status_registered = true order by pulse_record`,
+			strings.Join(models.Transaction{}.Fields(), ",")),
+		pg.Array(depositReferences))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch deposit txs")
+	}
+	return txs, nil
+}
+
+// GetPulseRangeByTime resolves from and to unix timestamps to the first and the last pulse between them.
+// The first pulse is greater than the last one if there are no pulses in the range.
+func GetPulseRangeByTime(ctx context.Context, db Querier, from, to int64) (int64, int64, error) {
+	var fromPulse, toPulse int64
+	_, err := db.QueryOneContext(ctx, pg.Scan(&fromPulse, &toPulse), `
+		select
+			coalesce((select min(pulse) from pulses where pulse_date >= ?0), ?2),
+			coalesce((select max(pulse) from pulses where pulse_date < ?1), 0)`,
+		from*time.Second.Nanoseconds(), (to+1)*time.Second.Nanoseconds(), int64(1<<62))
+	if err != nil {
+		return 0, 0, errors.Wrap(err, "failed to resolve pulse range")
+	}
+	return fromPulse, toPulse, nil
+}
+
+// GetPulseAt fetches the pulse with pulse_date nearest to the unix timestamp, the earlier one wins a tie.
+func GetPulseAt(ctx context.Context, db Querier, timestamp int64) (*models.Pulse, error) {
+	var pulses []models.Pulse
+	date := timestamp * time.Second.Nanoseconds()
+	_, err := db.QueryContext(ctx, &pulses, `
+		(select * from pulses where pulse_date >= ?0 order by pulse_date limit 1)
+		union all
+		(select * from pulses where pulse_date < ?0 order by pulse_date desc limit 1)`,
+		date)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch pulse")
+	}
+	if len(pulses) == 0 {
+		return nil, ErrPulseNotFound
+	}
+	nearest := pulses[0]
+	for _, p := range pulses[1:] {
+		if abs(p.PulseDate-date) <= abs(nearest.PulseDate-date) {
+			nearest = p
+		}
+	}
+	return &nearest, nil
+}
+
+// GetNetworkStatsHistory returns the last network stats in every `interval` (a date_trunc field) between from and to,
+// Created is the start of the interval and MaxTPS is the maximum in the interval.
+func GetNetworkStatsHistory(ctx context.Context, db Querier, from, to time.Time, interval string) ([]models.NetworkStats, error) {
+	var res []models.NetworkStats
+	_, err := db.QueryContext(ctx, &res, `
+		select distinct on (date_trunc(?0, s.created))
+			date_trunc(?0, s.created) as created, s.pulse_number, s.total_transactions, s.month_transactions,
+			s.total_accounts, s.nodes, s.current_tps, max(s.max_tps) over (partition by date_trunc(?0, s.created)) as max_tps
+		from network_stats s
+		where s.created >= ?1 and s.created <= ?2
+		order by date_trunc(?0, s.created), s.created desc`,
+		interval, from, to)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch network stats history")
+	}
+	return res, nil
+}
+
+// GetSupplyStatsHistory returns the last total supply in every `interval` (a date_trunc field) between from and to,
+// Created is the start of the interval.
+func GetSupplyStatsHistory(ctx context.Context, db Querier, from, to time.Time, interval string) ([]models.SupplyStats, error) {
+	var res []models.SupplyStats
+	_, err := db.QueryContext(ctx, &res, `
+		select distinct on (date_trunc(?0, s.created)) date_trunc(?0, s.created) as created, s.total
+		from supply_stats s
+		where s.created >= ?1 and s.created <= ?2
+		order by date_trunc(?0, s.created), s.created desc`,
+		interval, from, to)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch supply stats history")
+	}
+	return res, nil
+}
+
+// GetPulse fetches the pulse with its nodes ordered by role and reference.
+func GetPulse(ctx context.Context, db Querier, number int64) (*models.Pulse, []models.PulseNode, error) {
+	p := &models.Pulse{}
+	_, err := db.QueryOneContext(ctx, p, `select * from pulses where pulse = ?0`, number)
+	if err != nil {
+		if err == pg.ErrNoRows {
+			return nil, nil, ErrPulseNotFound
+		}
+		return nil, nil, errors.Wrap(err, "failed to fetch pulse")
+	}
+	var nodes []models.PulseNode
+	_, err = db.QueryContext(ctx, &nodes, `select * from pulse_nodes where pulse = ?0 order by role, node_ref`, number)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to fetch pulse nodes")
+	}
+	return p, nodes, nil
+}
+
+// GetLastPulseNumber returns the last stored pulse, it is 0 if there are no pulses.
+func GetLastPulseNumber(ctx context.Context, db Querier) (int64, error) {
+	var last int64
+	_, err := db.QueryOneContext(ctx, pg.Scan(&last), `select coalesce(max(pulse), 0) from pulses`)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to fetch last pulse")
+	}
+	return last, nil
+}
+
+// CountPulseTransactions counts transactions registered in the pulse.
+func CountPulseTransactions(ctx context.Context, db Querier, number int64) (int64, error) {
+	var count int64
+	_, err := db.QueryOneContext(ctx, pg.Scan(&count), `
+		select count(*) from simple_transactions
+		where pulse_record >= array[?0, 0]::bigint[] and pulse_record < array[?0 + 1, 0]::bigint[]
+			and status_registered`,
+		number)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to count pulse transactions")
+	}
+	return count, nil
+}
+
+// SetPulseDates sets PulseDate of the transactions from the pulses table with one query.
+func SetPulseDates(ctx context.Context, db Querier, txs []models.Transaction) error {
+	if len(txs) == 0 {
+		return nil
+	}
+	numbers := make([]int64, 0, len(txs))
+	for _, tx := range txs {
+		numbers = append(numbers, tx.PulseNumber())
+	}
+	var pulses []models.Pulse
+	_, err := db.QueryContext(ctx, &pulses, `select pulse, pulse_date from pulses where pulse = ANY(?0)`, pg.Array(numbers))
+	if err != nil {
+		return errors.Wrap(err, "failed to fetch pulse dates")
+	}
+	dates := make(map[int64]int64, len(pulses))
+	for _, p := range pulses {
+		dates[int64(p.Pulse)] = p.PulseDate / time.Second.Nanoseconds()
+	}
+	for i := range txs {
+		txs[i].PulseDate = dates[txs[i].PulseNumber()]
+	}
+	return nil
+}
+
+func abs(n int64) int64 {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+// GetTxs is GetTx for several transactions with one query, missing ones are skipped.
+func GetTxs(ctx context.Context, db Querier, txIDs [][]byte) ([]models.Transaction, error) {
+	var txs []models.Transaction
+	_, err := db.QueryContext(ctx, &txs,
+		fmt.Sprintf( // nolint: gosec
+			`select %s from simple_transactions where tx_id = ANY(?0) and status_registered = true`, strings.Join(models.Transaction{}.Fields(), ",")),
+		pg.Array(txIDs))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch txs")
+	}
+	return txs, nil
+}
+
 func FilterByStatus(query *orm.Query, status string) (*orm.Query, error) {
 	switch status {
 	case "registered":
@@ -784,6 +1051,25 @@ func FilterByPulse(query *orm.Query, fromPulse, toPulse int64) (*orm.Query, erro
 	return query, nil
 }
 
+// FilterByTimestamp keeps transactions from pulses with pulse_date between from and to unix timestamps inclusive.
+// Bounds are resolved to pulse numbers with the pulses table, closed transactions are filtered by the finish pulse.
+func FilterByTimestamp(query *orm.Query, from, to *int64, indexType models.TxIndexType) (*orm.Query, error) {
+	if from != nil && to != nil && *from > *to {
+		return query, errors.New("Invalid input range: fromTimestamp must chronologically precede toTimestamp") // nolint
+	}
+	column := "pulse_record[1]"
+	if indexType == models.TxIndexTypeFinishPulseRecord {
+		column = "finish_pulse_record[1]"
+	}
+	if from != nil {
+		query = query.Where(column+" >= (select min(pulse) from pulses where pulse_date >= ?)", *from*time.Second.Nanoseconds())
+	}
+	if to != nil {
+		query = query.Where(column+" <= (select max(pulse) from pulses where pulse_date < ?)", (*to+1)*time.Second.Nanoseconds())
+	}
+	return query, nil
+}
+
 func FilterByMemberReferenceAndDirection(query *orm.Query, ref *insolar.Reference, d *string) (*orm.Query, error) {
 	direction := "all"
 	if d != nil {
@@ -891,11 +1177,15 @@ func holderTemplate(columns int) string {
 	return b.String()
 }
 
+// GetNotification returns the active notification for all audiences with the highest priority,
+// the ones without locale go first.
 func GetNotification(ctx context.Context, db Querier) (models.Notification, error) {
 	res := models.Notification{}
 	_, err := db.QueryOneContext(
 		ctx, &res,
-		`SELECT * FROM notifications WHERE NOW() BETWEEN start AND stop ORDER BY start DESC LIMIT 1`,
+		`SELECT * FROM notifications WHERE NOW() BETWEEN start AND stop AND audience = ?
+		ORDER BY priority DESC, locale = '' DESC, start DESC LIMIT 1`,
+		models.NotificationAudienceAll,
 	)
 	if err != nil {
 		if err == pg.ErrNoRows {
@@ -906,6 +1196,24 @@ func GetNotification(ctx context.Context, db Querier) (models.Notification, erro
 	return res, nil
 }
 
+// GetActiveNotifications returns active notifications for the audience and locale ordered by priority.
+// Empty audience or locale matches any.
+func GetActiveNotifications(ctx context.Context, db Querier, audience, locale string) ([]models.Notification, error) {
+	var res []models.Notification
+	_, err := db.QueryContext(
+		ctx, &res,
+		`SELECT * FROM notifications WHERE NOW() BETWEEN start AND stop
+			AND (?0 = '' OR audience IN (?0, ?1))
+			AND (?2 = '' OR locale IN (?2, ''))
+		ORDER BY priority DESC, start DESC, id`,
+		audience, models.NotificationAudienceAll, locale,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch notifications")
+	}
+	return res, nil
+}
+
 func GetBurnedBalance(db orm.DB) (*models.BurnedBalance, error) {
 	burnedBalance := &models.BurnedBalance{
 		Balance: "0",
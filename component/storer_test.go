@@ -118,10 +118,12 @@ func TestStoreSimpleTransactions(t *testing.T) {
 		res, err := tx.Query(&selected, `SELECT * FROM simple_transactions WHERE amount = ? ORDER BY tx_id`, amount)
 		require.NoError(t, err)
 		require.Equal(t, 2, res.RowsReturned())
-		// Reset ID field to simplify comparing.
-		for i, t := range selected {
-			t.ID = 0
-			selected[i] = t
+		// Reset ID and change fields to simplify comparing.
+		for i, tx := range selected {
+			require.NotZero(t, tx.ChangeSeq)
+			tx.ID = 0
+			tx.ChangeSeq = 0
+			selected[i] = tx
 		}
 		// Sort expected slice.
 		sort.Slice(expectedTransactions, func(i, j int) bool {
@@ -565,6 +567,8 @@ func TestStoreTxDepositData(t *testing.T) {
 		}
 
 		res.ID = 1
+		require.NotZero(t, res.ChangeSeq)
+		res.ChangeSeq = 0
 
 		require.Equal(t, expectedTransactions[0], *res)
 
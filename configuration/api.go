@@ -15,9 +15,20 @@ type APIConfig interface {
 	GetDB() DB
 	GetLog() Log
 	GetFeeAmount() *big.Int
+	GetFeeCacheTTL() time.Duration
 	GetPriceOrigin() string
 	GetPrice() string
 	GetCMCMarketStatsParams() CMCMarketStatsParamsEnabled
+	GetSchemaCheck() string
+	GetReplicas() Replicas
+	GetCursorSecret() string
+	GetWebhooks() Webhooks
+	GetAuth() APIAuth
+	GetRateLimit() RateLimit
+	GetGraphQL() GraphQL
+	GetGRPC() GRPC
+	GetBatchLimit() int
+	GetResponseCache() ResponseCache
 }
 
 type CMCMarketStatsParamsEnabled struct {
@@ -31,13 +42,137 @@ type API struct {
 	Listen string
 	DB     DB
 	Log    Log
+	// What to do if db schema version differs from the expected one: strict|readonly|off.
+	SchemaCheck string
+	Replicas    Replicas
+	// Key to sign pagination cursors, random on every start if empty.
+	// Must be the same for all API instances behind a balancer.
+	CursorSecret string
+	Webhooks     Webhooks
+	Auth         APIAuth
+	RateLimit    RateLimit
+	GraphQL      GraphQL
+	GRPC         GRPC
+	// Max number of references or tx IDs in one batch lookup request.
+	BatchLimit    int
+	ResponseCache ResponseCache
+}
+
+// GRPC serves the observer API over gRPC alongside REST, API keys and JWT are passed in metadata.
+type GRPC struct {
+	Enabled bool
+	Listen  string
+}
+
+// GraphQL queries are rejected before execution if they are too expensive.
+type GraphQL struct {
+	// Estimated number of resolved objects, connections multiply the cost of their nodes by page size
+	MaxCost int
+	// Nesting of fields, introspection isn't counted
+	MaxDepth int
+}
+
+// RateLimit limits requests of every client, which is the API key or JWT subject if present and IP otherwise.
+// Buckets refill at Rate requests per second up to Burst.
+type RateLimit struct {
+	Enabled bool
+	Rate    float64
+	Burst   int
+	// Routes with their own bucket, trailing * matches any suffix
+	ExpensiveRoutes []string
+	ExpensiveRate   float64
+	ExpensiveBurst  int
+	// Every IP is limited before authentication too, so invalid credentials are limited as well.
+	// It should be above Rate because of clients behind one IP, 0 turns it off.
+	IPRate  float64
+	IPBurst int
+	// Addresses or CIDRs of proxies in front of the API, X-Forwarded-For and X-Real-IP are trusted only from them.
+	// Clients are limited by the remote address otherwise.
+	TrustedProxies []string
+	// Share buckets between API instances through db, every instance counts on its own otherwise
+	Shared bool
+	// Buckets of clients idle this long are dropped
+	IdleTimeout time.Duration
+}
+
+// ResponseCache keeps responses of Routes until the observer stores a new pulse.
+// Data that doesn't change with pulses, like stats and notifications, is cached for TTL at most.
+type ResponseCache struct {
+	Enabled bool
+	// Route paths, trailing * matches any suffix
+	Routes []string
+	// Max number of responses kept in memory
+	Size int
+	// How long responses are kept at most, 10s if not set
+	TTL time.Duration
+	// How often the last pulse is checked
+	PollInterval time.Duration
+	// Address of a Redis-compatible server to share responses between API instances, they are kept in memory if empty
+	Redis string
+}
+
+// APIAuth protects endpoints with API keys and JWT. Every endpoint requires one of the scopes: public, admin or export.
+type APIAuth struct {
+	Enabled bool
+	// Scopes granted to requests without credentials
+	Anonymous []string
+	JWT       JWT
+}
+
+// JWT carries scopes in the space separated `scope` claim.
+type JWT struct {
+	// Key for HS256, HS384 and HS512 tokens, they are rejected if empty
+	Secret string
+	// PEM encoded RSA public key for RS256, RS384 and RS512 tokens, they are rejected if empty
+	PublicKeyFile string
+	// Expected `iss` and `aud` claims, not checked if empty
+	Issuer   string
+	Audience string
+}
+
+// Webhooks deliver finalized transactions and balance changes of watched members to subscribers.
+type Webhooks struct {
+	// Token for /admin/webhooks endpoints unless Auth is enabled, webhooks are disabled if empty.
+	AdminToken string
+	// Number of concurrent deliveries
+	Workers int
+	// Deliveries failed this many times are moved to the dead-letter list
+	MaxAttempts int
+	// Delay before the first retry, doubled for every next one up to MaxRetryInterval
+	RetryInterval    time.Duration
+	MaxRetryInterval time.Duration
+	// Timeout of a subscriber response
+	Timeout time.Duration
+}
+
+// Replicas are read-only copies of DB, other connection settings are taken from DB.
+type Replicas struct {
+	URLs []string
+	// Max number of pulses replica could be behind the primary db to serve reads
+	MaxLag int
+	// Interval between replicas lag checks
+	CheckInterval time.Duration
 }
 
 func (API) Default() *API {
 	return &API{
 		Listen: ":0",
 		DB: DB{
-			URL:             "postgres://postgres@localhost/postgres?sslmode=disable",
+			URL:              "postgres://postgres@localhost/postgres?sslmode=disable",
+			Password:         "",
+			PasswordFile:     "",
+			IdleTimeout:      5 * time.Minute,
+			MaxConnAge:       0,
+			StatementTimeout: 0,
+			ApplicationName:  "observer-api",
+			TLS: TLS{
+				Enabled:            false,
+				CAFile:             "",
+				CertFile:           "",
+				KeyFile:            "",
+				ServerName:         "",
+				InsecureSkipVerify: false,
+			},
 			Attempts:        5,
 			AttemptInterval: 3 * time.Second,
 		},
@@ -48,6 +183,61 @@ func (API) Default() *API {
 			OutputParams: "",
 			Buffer:       0,
 		},
+		SchemaCheck:  SchemaCheckStrict,
+		CursorSecret: "",
+		Replicas: Replicas{
+			URLs:          []string{},
+			MaxLag:        5,
+			CheckInterval: 10 * time.Second,
+		},
+		Webhooks: Webhooks{
+			AdminToken:       "",
+			Workers:          4,
+			MaxAttempts:      10,
+			RetryInterval:    10 * time.Second,
+			MaxRetryInterval: time.Hour,
+			Timeout:          10 * time.Second,
+		},
+		Auth: APIAuth{
+			Enabled:   false,
+			Anonymous: []string{"public"},
+			JWT: JWT{
+				Secret:        "",
+				PublicKeyFile: "",
+				Issuer:        "",
+				Audience:      "",
+			},
+		},
+		RateLimit: RateLimit{
+			Enabled:         false,
+			Rate:            20,
+			Burst:           40,
+			ExpensiveRoutes: []string{"/api/transactions", "/api/member/:reference/statement", "/api/stats/*", "/api/batch/*"},
+			ExpensiveRate:   1,
+			ExpensiveBurst:  5,
+			IPRate:          100,
+			IPBurst:         200,
+			TrustedProxies:  []string{},
+			Shared:          false,
+			IdleTimeout:     10 * time.Minute,
+		},
+		GraphQL: GraphQL{
+			MaxCost:  5000,
+			MaxDepth: 10,
+		},
+		GRPC: GRPC{
+			Enabled: false,
+			Listen:  ":0",
+		},
+		BatchLimit: 1000,
+		ResponseCache: ResponseCache{
+			Enabled:      false,
+			Routes:       []string{"/api/member/:reference", "/api/member/:reference/balance", "/api/stats/*", "/api/notification", "/api/notifications"},
+			Size:         10000,
+			TTL:          10 * time.Second,
+			PollInterval: time.Second,
+			Redis:        "",
+		},
 	}
 }
 
@@ -87,10 +277,54 @@ func (a API) GetLog() Log {
 	return a.Log
 }
 
+func (a API) GetSchemaCheck() string {
+	return a.SchemaCheck
+}
+
+func (a API) GetReplicas() Replicas {
+	return a.Replicas
+}
+
+func (a API) GetCursorSecret() string {
+	return a.CursorSecret
+}
+
+func (a API) GetWebhooks() Webhooks {
+	return a.Webhooks
+}
+
+func (a API) GetAuth() APIAuth {
+	return a.Auth
+}
+
+func (a API) GetRateLimit() RateLimit {
+	return a.RateLimit
+}
+
+func (a API) GetGraphQL() GraphQL {
+	return a.GraphQL
+}
+
+func (a API) GetGRPC() GRPC {
+	return a.GRPC
+}
+
+func (a API) GetBatchLimit() int {
+	return a.BatchLimit
+}
+
+func (a API) GetResponseCache() ResponseCache {
+	return a.ResponseCache
+}
+
 func (a API) GetFeeAmount() *big.Int {
 	panic("shouldn't be implemented for the type API")
 }
 
+func (a API) GetFeeCacheTTL() time.Duration {
+	panic("shouldn't be implemented for the type API")
+}
+
 func (a API) GetPriceOrigin() string {
 	panic("shouldn't be implemented for the type API")
 }
@@ -11,6 +11,7 @@ type APIExtended struct {
 	API `mapstructure:",squash"`
 
 	FeeAmount            *big.Int
+	FeeCacheTTL          time.Duration
 	PriceOrigin          string
 	Price                string
 	CMCMarketStatsParams CMCMarketStatsParamsEnabled
@@ -21,7 +22,21 @@ func (APIExtended) Default() *APIExtended {
 		API: API{
 			Listen: ":0",
 			DB: DB{
-				URL:             "postgres://postgres@localhost/postgres?sslmode=disable",
+				URL:              "postgres://postgres@localhost/postgres?sslmode=disable",
+				Password:         "",
+				PasswordFile:     "",
+				IdleTimeout:      5 * time.Minute,
+				MaxConnAge:       0,
+				StatementTimeout: 0,
+				ApplicationName:  "observer-api",
+				TLS: TLS{
+					Enabled:            false,
+					CAFile:             "",
+					CertFile:           "",
+					KeyFile:            "",
+					ServerName:         "",
+					InsecureSkipVerify: false,
+				},
 				Attempts:        5,
 				AttemptInterval: 3 * time.Second,
 			},
@@ -32,8 +47,64 @@ func (APIExtended) Default() *APIExtended {
 				OutputParams: "",
 				Buffer:       0,
 			},
+			SchemaCheck:  SchemaCheckStrict,
+			CursorSecret: "",
+			Replicas: Replicas{
+				URLs:          []string{},
+				MaxLag:        5,
+				CheckInterval: 10 * time.Second,
+			},
+			Webhooks: Webhooks{
+				AdminToken:       "",
+				Workers:          4,
+				MaxAttempts:      10,
+				RetryInterval:    10 * time.Second,
+				MaxRetryInterval: time.Hour,
+				Timeout:          10 * time.Second,
+			},
+			Auth: APIAuth{
+				Enabled:   false,
+				Anonymous: []string{"public"},
+				JWT: JWT{
+					Secret:        "",
+					PublicKeyFile: "",
+					Issuer:        "",
+					Audience:      "",
+				},
+			},
+			RateLimit: RateLimit{
+				Enabled:         false,
+				Rate:            20,
+				Burst:           40,
+				ExpensiveRoutes: []string{"/api/transactions", "/api/member/:reference/statement", "/api/stats/*", "/api/batch/*"},
+				ExpensiveRate:   1,
+				ExpensiveBurst:  5,
+				IPRate:          100,
+				IPBurst:         200,
+				TrustedProxies:  []string{},
+				Shared:          false,
+				IdleTimeout:     10 * time.Minute,
+			},
+			GraphQL: GraphQL{
+				MaxCost:  5000,
+				MaxDepth: 10,
+			},
+			GRPC: GRPC{
+				Enabled: false,
+				Listen:  ":0",
+			},
+			BatchLimit: 1000,
+			ResponseCache: ResponseCache{
+				Enabled:      false,
+				Routes:       []string{"/api/member/:reference", "/api/member/:reference/balance", "/api/stats/*", "/api/notification", "/api/notifications"},
+				Size:         10000,
+				TTL:          10 * time.Second,
+				PollInterval: time.Second,
+				Redis:        "",
+			},
 		},
 		FeeAmount:   big.NewInt(1000000000),
+		FeeCacheTTL: time.Minute,
 		Price:       "0.05",
 		PriceOrigin: "const", // const|binance|coin_market_cap
 		CMCMarketStatsParams: CMCMarketStatsParamsEnabled{
@@ -57,10 +128,54 @@ func (a APIExtended) GetLog() Log {
 	return a.Log
 }
 
+func (a APIExtended) GetSchemaCheck() string {
+	return a.SchemaCheck
+}
+
+func (a APIExtended) GetReplicas() Replicas {
+	return a.Replicas
+}
+
+func (a APIExtended) GetCursorSecret() string {
+	return a.CursorSecret
+}
+
+func (a APIExtended) GetWebhooks() Webhooks {
+	return a.Webhooks
+}
+
+func (a APIExtended) GetAuth() APIAuth {
+	return a.Auth
+}
+
+func (a APIExtended) GetRateLimit() RateLimit {
+	return a.RateLimit
+}
+
+func (a APIExtended) GetGraphQL() GraphQL {
+	return a.GraphQL
+}
+
+func (a APIExtended) GetGRPC() GRPC {
+	return a.GRPC
+}
+
+func (a APIExtended) GetBatchLimit() int {
+	return a.BatchLimit
+}
+
+func (a APIExtended) GetResponseCache() ResponseCache {
+	return a.ResponseCache
+}
+
 func (a APIExtended) GetFeeAmount() *big.Int {
 	return a.FeeAmount
 }
 
+func (a APIExtended) GetFeeCacheTTL() time.Duration {
+	return a.FeeCacheTTL
+}
+
 func (a APIExtended) GetPriceOrigin() string {
 	return a.PriceOrigin
 }
@@ -33,5 +33,24 @@ func TestConfigLoad(t *testing.T) {
 	}
 
 	require.Equal(t, big.NewInt(2000000000), cfg.FeeAmount)
+	require.Equal(t, 30*time.Second, cfg.FeeCacheTTL)
 	require.Equal(t, time.Second*3, cfg.DB.AttemptInterval)
+	require.Len(t, cfg.Replicas.URLs, 1)
+	require.Equal(t, time.Second*10, cfg.Replicas.CheckInterval)
+	require.Equal(t, "secret", cfg.CursorSecret)
+	require.Equal(t, "token", cfg.Webhooks.AdminToken)
+	require.Equal(t, time.Hour, cfg.Webhooks.MaxRetryInterval)
+	require.True(t, cfg.Auth.Enabled)
+	require.Equal(t, []string{"public"}, cfg.Auth.Anonymous)
+	require.Equal(t, "observer", cfg.Auth.JWT.Issuer)
+	require.Equal(t, 0.5, cfg.RateLimit.ExpensiveRate)
+	require.Len(t, cfg.RateLimit.ExpensiveRoutes, 3)
+	require.Equal(t, float64(50), cfg.RateLimit.IPRate)
+	require.Equal(t, []string{"10.0.0.0/8"}, cfg.RateLimit.TrustedProxies)
+	require.Equal(t, 2000, cfg.GraphQL.MaxCost)
+	require.Equal(t, "0.0.0.0:8091", cfg.GRPC.Listen)
+	require.Equal(t, 500, cfg.BatchLimit)
+	require.Len(t, cfg.ResponseCache.Routes, 2)
+	require.Equal(t, 5*time.Second, cfg.ResponseCache.TTL)
+	require.Equal(t, "localhost:6379", cfg.ResponseCache.Redis)
 }
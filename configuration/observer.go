@@ -10,8 +10,21 @@ type Observer struct {
 	Log        Log
 	DB         DB
 	Replicator Replicator
+	// What to do if db schema version differs from the expected one: strict|off.
+	// Observer writes to db, so readonly works as strict.
+	SchemaCheck string
 }
 
+// Modes of db schema version check at startup.
+const (
+	// SchemaCheckStrict refuses to start.
+	SchemaCheckStrict = "strict"
+	// SchemaCheckReadOnly starts without endpoints writing to db.
+	SchemaCheckReadOnly = "readonly"
+	// SchemaCheckOff only logs the mismatch.
+	SchemaCheckOff = "off"
+)
+
 type Log struct {
 	Level        string
 	Format       string
@@ -21,13 +34,39 @@ type Log struct {
 }
 
 type DB struct {
-	URL      string
-	PoolSize int
-	Attempts cycle.Limit
+	URL string
+	// Overrides password from URL, could be set with env
+	Password string
+	// File with password, takes precedence over Password
+	PasswordFile string
+	PoolSize     int
+	// Idle connections are closed after this timeout, 0 means 5 minutes and -1 means never
+	IdleTimeout time.Duration
+	// Connections are closed after this age, 0 means never
+	MaxConnAge time.Duration
+	// statement_timeout of every connection, 0 means no timeout
+	StatementTimeout time.Duration
+	// Overrides application_name from URL
+	ApplicationName string
+	TLS             TLS
+	Attempts        cycle.Limit
 	// Interval between store in db failed attempts
 	AttemptInterval time.Duration
 }
 
+type TLS struct {
+	Enabled bool
+	// PEM file with CA certificates to verify db server, system pool is used if empty
+	CAFile string
+	// Client certificate and key, both empty if client auth isn't used
+	CertFile string
+	KeyFile  string
+	// Name to verify server certificate with, host from URL is used if empty
+	ServerName string
+	// warning: set true only for testing purpose within secured environment
+	InsecureSkipVerify bool
+}
+
 type Replicator struct {
 	Addr            string
 	MaxTransportMsg int
@@ -79,8 +118,22 @@ func (Observer) Default() *Observer {
 			Listen: ":8888",
 		},
 		DB: DB{
-			URL:             "postgres://postgres@localhost/postgres?sslmode=disable",
-			PoolSize:        100,
+			URL:              "postgres://postgres@localhost/postgres?sslmode=disable",
+			Password:         "",
+			PasswordFile:     "",
+			PoolSize:         100,
+			IdleTimeout:      5 * time.Minute,
+			MaxConnAge:       0,
+			StatementTimeout: 0,
+			ApplicationName:  "observer",
+			TLS: TLS{
+				Enabled:            false,
+				CAFile:             "",
+				CertFile:           "",
+				KeyFile:            "",
+				ServerName:         "",
+				InsecureSkipVerify: false,
+			},
 			Attempts:        5,
 			AttemptInterval: 3 * time.Second,
 		},
@@ -91,5 +144,6 @@ func (Observer) Default() *Observer {
 			OutputParams: "",
 			Buffer:       0,
 		},
+		SchemaCheck: SchemaCheckStrict,
 	}
 }
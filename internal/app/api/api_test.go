@@ -14,8 +14,10 @@ import (
 
 	"github.com/insolar/insolar/instrumentation/inslogger"
 
+	"github.com/insolar/observer/configuration"
 	"github.com/insolar/observer/internal/app/observer"
 	"github.com/insolar/observer/internal/app/observer/postgres"
+	"github.com/insolar/observer/internal/dbconn"
 	"github.com/insolar/observer/internal/models"
 	"github.com/insolar/observer/internal/testutils"
 )
@@ -51,6 +53,14 @@ func TestMain(t *testing.M) {
 	observerAPI := NewServer(db, logger, pStorage)
 
 	RegisterHandlers(e, observerAPI)
+	RegisterStatementHandler(e, observerAPI.(StatementServer))
+	RegisterDepositHandlers(e, observerAPI.(DepositServer))
+	RegisterPulseHandlers(e, observerAPI.(PulseServer))
+	RegisterRecordHandlers(e, observerAPI.(RecordServer))
+	stream := NewStream(dbconn.NewPool(db, logger), logger)
+	go stream.Run(context.Background())
+	RegisterStreamHandler(e, stream)
+	RegisterBatchHandlers(e, NewBatch(dbconn.NewPool(db, logger), logger, configuration.API{}.Default()))
 	go func() {
 		err := e.Start(apihost)
 		dbCleaner()
@@ -79,7 +89,7 @@ func truncateDB(t *testing.T) {
 	_, err = db.Model(&models.AugmentedAddress{}).Exec("TRUNCATE TABLE ?TableName CASCADE")
 	require.NoError(t, err)
 
-	_, err = db.Exec("TRUNCATE TABLE pulses CASCADE")
+	_, err = db.Exec("TRUNCATE TABLE pulses, pulse_nodes CASCADE")
 	require.NoError(t, err)
 	nowPulse := 1575302444 - pulse.UnixTimeOfMinTimePulse + pulse.MinTimePulse
 	_ = pStorage.Insert(&observer.Pulse{Number: pulse.Number(nowPulse)})
@@ -0,0 +1,209 @@
+// Package auth checks API keys and JWT and lets only requests with the required scope through.
+package auth
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/insolar/insolar/insolar"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/insolar/observer/configuration"
+	"github.com/insolar/observer/internal/app/api"
+	"github.com/insolar/observer/internal/dbconn"
+)
+
+const (
+	ScopePublic = "public"
+	ScopeAdmin  = "admin"
+	ScopeExport = "export"
+
+	HeaderAPIKey = "X-API-Key"
+
+	clientKey = "auth.client"
+
+	// Revoked keys stop working after this time at most.
+	keyCacheTTL = 30 * time.Second
+)
+
+var Scopes = []string{ScopePublic, ScopeAdmin, ScopeExport}
+
+var errInvalidCredentials = errors.New("Credentials are invalid.") // nolint
+
+// Authenticator checks `X-API-Key` header or `Authorization: Bearer` with an API key or JWT.
+type Authenticator struct {
+	db     *dbconn.Pool
+	log    insolar.Logger
+	cfg    configuration.APIAuth
+	rsaKey *rsa.PublicKey
+
+	mu   sync.Mutex
+	keys map[string]cachedKey
+}
+
+type cachedKey struct {
+	id     int64
+	scopes []string
+	until  time.Time
+}
+
+type claims struct {
+	Scope string `json:"scope"`
+	jwt.RegisteredClaims
+}
+
+func New(db *dbconn.Pool, log insolar.Logger, cfg configuration.APIAuth) (*Authenticator, error) {
+	a := &Authenticator{db: db, log: log, cfg: cfg, keys: make(map[string]cachedKey)}
+	if cfg.JWT.PublicKeyFile != "" {
+		pem, err := ioutil.ReadFile(cfg.JWT.PublicKeyFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read jwt public key")
+		}
+		a.rsaKey, err = jwt.ParseRSAPublicKeyFromPEM(pem)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse jwt public key")
+		}
+	}
+	return a, nil
+}
+
+func (a *Authenticator) Enabled() bool {
+	return a.cfg.Enabled
+}
+
+// Require rejects requests without the scope, it lets everything through if auth is disabled.
+func (a *Authenticator) Require(scope string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			if !a.cfg.Enabled {
+				return next(ctx)
+			}
+			req := ctx.Request()
+			scopes, client, err := a.authenticate(req.Context(), req.Header.Get(HeaderAPIKey), req.Header.Get(echo.HeaderAuthorization))
+			if err != nil {
+				ctx.Response().Header().Set(echo.HeaderWWWAuthenticate, "Bearer")
+				return ctx.JSON(http.StatusUnauthorized, api.NewSingleMessageError(err.Error()))
+			}
+			if !contains(scopes, scope) {
+				if client == "" {
+					ctx.Response().Header().Set(echo.HeaderWWWAuthenticate, "Bearer")
+					return ctx.JSON(http.StatusUnauthorized, api.NewSingleMessageError("Credentials are required."))
+				}
+				return ctx.JSON(http.StatusForbidden, api.NewSingleMessageError("Scope '"+scope+"' is required."))
+			}
+			if client != "" {
+				ctx.Set(clientKey, client)
+			}
+			return next(ctx)
+		}
+	}
+}
+
+// Client identifies the authenticated client as `key:<id>` or `jwt:<subject>`, it is empty for anonymous requests.
+func Client(ctx echo.Context) string {
+	client, _ := ctx.Get(clientKey).(string)
+	return client
+}
+
+func (a *Authenticator) authenticate(ctx context.Context, apiKey, authorization string) ([]string, string, error) {
+	token := apiKey
+	if token == "" {
+		if authorization == "" {
+			return a.cfg.Anonymous, "", nil
+		}
+		if !strings.HasPrefix(authorization, "Bearer ") {
+			return nil, "", errInvalidCredentials
+		}
+		token = strings.TrimPrefix(authorization, "Bearer ")
+	}
+
+	if strings.HasPrefix(token, keyPrefix) {
+		return a.keyScopes(ctx, token)
+	}
+	return a.tokenScopes(token)
+}
+
+func (a *Authenticator) keyScopes(ctx context.Context, key string) ([]string, string, error) {
+	hash := HashKey(key)
+	cacheKey := hex.EncodeToString(hash)
+	now := time.Now()
+
+	a.mu.Lock()
+	cached, ok := a.keys[cacheKey]
+	a.mu.Unlock()
+	if ok && now.Before(cached.until) {
+		return cached.scopes, keyClient(cached.id), nil
+	}
+
+	// Primary is used to see revocations right away.
+	apiKey, err := FindKey(ctx, a.db.Primary(), hash)
+	if err != nil {
+		if err != ErrKeyNotFound {
+			a.log.Error(err)
+		}
+		return nil, "", errInvalidCredentials
+	}
+	until := now.Add(keyCacheTTL)
+	if apiKey.Expires != nil && apiKey.Expires.Before(until) {
+		until = *apiKey.Expires
+	}
+	a.mu.Lock()
+	for k, c := range a.keys {
+		if now.After(c.until) {
+			delete(a.keys, k)
+		}
+	}
+	a.keys[cacheKey] = cachedKey{id: apiKey.ID, scopes: apiKey.Scopes, until: until}
+	a.mu.Unlock()
+	return apiKey.Scopes, keyClient(apiKey.ID), nil
+}
+
+func keyClient(id int64) string {
+	return "key:" + strconv.FormatInt(id, 10)
+}
+
+func (a *Authenticator) tokenScopes(token string) ([]string, string, error) {
+	var opts []jwt.ParserOption
+	if a.cfg.JWT.Issuer != "" {
+		opts = append(opts, jwt.WithIssuer(a.cfg.JWT.Issuer))
+	}
+	if a.cfg.JWT.Audience != "" {
+		opts = append(opts, jwt.WithAudience(a.cfg.JWT.Audience))
+	}
+	c := claims{}
+	_, err := jwt.ParseWithClaims(token, &c, func(t *jwt.Token) (interface{}, error) {
+		switch t.Method.(type) {
+		case *jwt.SigningMethodHMAC:
+			if a.cfg.JWT.Secret != "" {
+				return []byte(a.cfg.JWT.Secret), nil
+			}
+		case *jwt.SigningMethodRSA:
+			if a.rsaKey != nil {
+				return a.rsaKey, nil
+			}
+		}
+		return nil, errors.Errorf("unexpected signing method %v", t.Header["alg"])
+	}, opts...)
+	if err != nil {
+		return nil, "", errInvalidCredentials
+	}
+	return strings.Fields(c.Scope), "jwt:" + c.Subject, nil
+}
+
+func contains(scopes []string, scope string) bool {
+	for _, s := range scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,133 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/insolar/observer/configuration"
+)
+
+func TestRequire(t *testing.T) {
+	cfg := configuration.APIAuth{
+		Enabled:   true,
+		Anonymous: []string{ScopePublic},
+		JWT:       configuration.JWT{Secret: "secret", Issuer: "observer", Audience: "observer-api"},
+	}
+	authn, err := New(nil, inslogger.FromContext(context.Background()), cfg)
+	require.NoError(t, err)
+
+	e := echo.New()
+	ok := func(ctx echo.Context) error { return ctx.NoContent(http.StatusOK) }
+	Router(e, authn.Require(ScopePublic)).GET("/public", ok)
+	Router(e, authn.Require(ScopeAdmin)).GET("/admin", ok)
+
+	token := func(method jwt.SigningMethod, key interface{}, c claims) string {
+		s, err := jwt.NewWithClaims(method, c).SignedString(key)
+		require.NoError(t, err)
+		return s
+	}
+	valid := jwt.RegisteredClaims{
+		Issuer:    "observer",
+		Audience:  jwt.ClaimStrings{"observer-api"},
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	}
+	adminToken := token(jwt.SigningMethodHS256, []byte("secret"), claims{Scope: "public admin", RegisteredClaims: valid})
+	publicToken := token(jwt.SigningMethodHS256, []byte("secret"), claims{Scope: "public", RegisteredClaims: valid})
+	forgedToken := token(jwt.SigningMethodHS256, []byte("other"), claims{Scope: "admin", RegisteredClaims: valid})
+	expired := valid
+	expired.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Hour))
+	expiredToken := token(jwt.SigningMethodHS256, []byte("secret"), claims{Scope: "admin", RegisteredClaims: expired})
+	otherIssuer := valid
+	otherIssuer.Issuer = "other"
+	otherIssuerToken := token(jwt.SigningMethodHS256, []byte("secret"), claims{Scope: "admin", RegisteredClaims: otherIssuer})
+	otherAudience := valid
+	otherAudience.Audience = jwt.ClaimStrings{"other"}
+	otherAudienceToken := token(jwt.SigningMethodHS256, []byte("secret"), claims{Scope: "admin", RegisteredClaims: otherAudience})
+	noAudience := valid
+	noAudience.Audience = nil
+	noAudienceToken := token(jwt.SigningMethodHS256, []byte("secret"), claims{Scope: "admin", RegisteredClaims: noAudience})
+
+	tests := []struct {
+		name   string
+		path   string
+		token  string
+		status int
+	}{
+		{"anonymous public", "/public", "", http.StatusOK},
+		{"anonymous admin", "/admin", "", http.StatusUnauthorized},
+		{"admin token", "/admin", adminToken, http.StatusOK},
+		{"public token on admin", "/admin", publicToken, http.StatusForbidden},
+		{"forged token", "/admin", forgedToken, http.StatusUnauthorized},
+		{"expired token", "/admin", expiredToken, http.StatusUnauthorized},
+		{"other issuer", "/admin", otherIssuerToken, http.StatusUnauthorized},
+		{"other audience", "/admin", otherAudienceToken, http.StatusUnauthorized},
+		{"no audience", "/admin", noAudienceToken, http.StatusUnauthorized},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, test.path, nil)
+			if test.token != "" {
+				req.Header.Set(echo.HeaderAuthorization, "Bearer "+test.token)
+			}
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+			require.Equal(t, test.status, rec.Code)
+		})
+	}
+}
+
+func TestRequire_Disabled(t *testing.T) {
+	authn, err := New(nil, inslogger.FromContext(context.Background()), configuration.APIAuth{})
+	require.NoError(t, err)
+
+	e := echo.New()
+	Router(e, authn.Require(ScopeAdmin)).GET("/admin", func(ctx echo.Context) error {
+		return ctx.NoContent(http.StatusOK)
+	})
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/admin", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestUnaryInterceptor(t *testing.T) {
+	cfg := configuration.APIAuth{
+		Enabled:   true,
+		Anonymous: []string{ScopePublic},
+		JWT:       configuration.JWT{Secret: "secret"},
+	}
+	authn, err := New(nil, inslogger.FromContext(context.Background()), cfg)
+	require.NoError(t, err)
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims{Scope: "public", RegisteredClaims: jwt.RegisteredClaims{Subject: "svc"}}).
+		SignedString([]byte("secret"))
+	require.NoError(t, err)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil }
+	call := func(scope string, md metadata.MD) codes.Code {
+		ctx := metadata.NewIncomingContext(context.Background(), md)
+		_, err := authn.UnaryInterceptor(scope)(ctx, nil, &grpc.UnaryServerInfo{}, handler)
+		return status.Code(err)
+	}
+
+	require.Equal(t, codes.OK, call(ScopePublic, metadata.MD{}))
+	require.Equal(t, codes.Unauthenticated, call(ScopeAdmin, metadata.MD{}))
+	require.Equal(t, codes.PermissionDenied, call(ScopeAdmin, metadata.Pairs("authorization", "Bearer "+token)))
+	require.Equal(t, codes.Unauthenticated, call(ScopePublic, metadata.Pairs("authorization", "Bearer invalid")))
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+	client, err := authn.UnaryInterceptor(ScopePublic)(ctx, nil, &grpc.UnaryServerInfo{},
+		func(ctx context.Context, req interface{}) (interface{}, error) { return ClientFromContext(ctx), nil })
+	require.NoError(t, err)
+	require.Equal(t, "jwt:svc", client)
+}
@@ -0,0 +1,79 @@
+package auth
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type clientContextKey struct{}
+
+// UnaryInterceptor is Require for gRPC, credentials are taken from `x-api-key` and `authorization` metadata.
+// The authenticated client is passed in the context, see ClientFromContext.
+func (a *Authenticator) UnaryInterceptor(scope string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := a.check(ctx, scope)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamInterceptor is UnaryInterceptor for streaming calls.
+func (a *Authenticator) StreamInterceptor(scope string) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := a.check(ss.Context(), scope)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// ClientFromContext is Client for gRPC calls, it is empty for anonymous ones.
+func ClientFromContext(ctx context.Context) string {
+	client, _ := ctx.Value(clientContextKey{}).(string)
+	return client
+}
+
+func (a *Authenticator) check(ctx context.Context, scope string) (context.Context, error) {
+	if !a.cfg.Enabled {
+		return ctx, nil
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	first := func(key string) string {
+		if values := md.Get(key); len(values) > 0 {
+			return values[0]
+		}
+		return ""
+	}
+	scopes, client, err := a.authenticate(ctx, first(strings.ToLower(HeaderAPIKey)), first("authorization"))
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	if !contains(scopes, scope) {
+		if client == "" {
+			return nil, status.Error(codes.Unauthenticated, "Credentials are required.")
+		}
+		return nil, status.Error(codes.PermissionDenied, "Scope '"+scope+"' is required.")
+	}
+	if client != "" {
+		ctx = context.WithValue(ctx, clientContextKey{}, client)
+	}
+	return ctx, nil
+}
+
+// serverStream replaces the context of the stream.
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,95 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"time"
+
+	"github.com/go-pg/pg"
+	"github.com/go-pg/pg/orm"
+	"github.com/pkg/errors"
+
+	"github.com/insolar/observer/internal/models"
+)
+
+// API keys start with the prefix to tell them from JWT.
+const keyPrefix = "obs_"
+
+var ErrKeyNotFound = errors.New("api key not found")
+
+// HashKey is what is stored in db instead of the key.
+func HashKey(key string) []byte {
+	sum := sha256.Sum256([]byte(key))
+	return sum[:]
+}
+
+// MintKey stores a new key with the scopes, the key itself is returned only here.
+func MintKey(ctx context.Context, db orm.DB, name string, scopes []string, expires *time.Time) (string, *models.APIKey, error) {
+	for _, scope := range scopes {
+		if !contains(Scopes, scope) {
+			return "", nil, errors.Errorf("unknown scope %q", scope)
+		}
+	}
+	if len(scopes) == 0 {
+		return "", nil, errors.New("at least one scope is required")
+	}
+
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", nil, errors.Wrap(err, "failed to generate api key")
+	}
+	key := keyPrefix + base64.RawURLEncoding.EncodeToString(secret)
+	apiKey := &models.APIKey{
+		Name:    name,
+		KeyHash: HashKey(key),
+		Scopes:  scopes,
+		Expires: expires,
+	}
+	if _, err := db.ModelContext(ctx, apiKey).Returning("*").Insert(); err != nil {
+		return "", nil, errors.Wrap(err, "failed to insert api key")
+	}
+	return key, apiKey, nil
+}
+
+// FindKey returns the key by hash unless it is revoked or expired.
+func FindKey(ctx context.Context, db orm.DB, hash []byte) (*models.APIKey, error) {
+	apiKey := &models.APIKey{}
+	err := db.ModelContext(ctx, apiKey).
+		Where("key_hash = ?", hash).
+		Where("revoked is null").
+		Where("expires is null or expires > now()").
+		Select()
+	if err != nil {
+		if err == pg.ErrNoRows {
+			return nil, ErrKeyNotFound
+		}
+		return nil, errors.Wrap(err, "failed to select api key")
+	}
+	return apiKey, nil
+}
+
+func ListKeys(ctx context.Context, db orm.DB) ([]models.APIKey, error) {
+	var keys []models.APIKey
+	if err := db.ModelContext(ctx, &keys).Order("id ASC").Select(); err != nil {
+		return nil, errors.Wrap(err, "failed to select api keys")
+	}
+	return keys, nil
+}
+
+// RevokeKey disables the key, API instances notice it within keyCacheTTL.
+func RevokeKey(ctx context.Context, db orm.DB, id int64) error {
+	res, err := db.ModelContext(ctx, &models.APIKey{}).
+		Set("revoked = now()").
+		Where("id = ?", id).
+		Where("revoked is null").
+		Update()
+	if err != nil {
+		return errors.Wrap(err, "failed to revoke api key")
+	}
+	if res.RowsAffected() == 0 {
+		return ErrKeyNotFound
+	}
+	return nil
+}
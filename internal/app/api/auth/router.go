@@ -0,0 +1,56 @@
+package auth
+
+import (
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+	"github.com/labstack/echo/v4"
+)
+
+// Router adds the middleware to every route registered through it, so generated handlers can be scoped as a whole.
+func Router(router runtime.EchoRouter, m ...echo.MiddlewareFunc) runtime.EchoRouter {
+	return scopedRouter{router: router, m: m}
+}
+
+type scopedRouter struct {
+	router runtime.EchoRouter
+	m      []echo.MiddlewareFunc
+}
+
+func (r scopedRouter) with(m []echo.MiddlewareFunc) []echo.MiddlewareFunc {
+	return append(append([]echo.MiddlewareFunc{}, r.m...), m...)
+}
+
+func (r scopedRouter) CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	return r.router.CONNECT(path, h, r.with(m)...)
+}
+
+func (r scopedRouter) DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	return r.router.DELETE(path, h, r.with(m)...)
+}
+
+func (r scopedRouter) GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	return r.router.GET(path, h, r.with(m)...)
+}
+
+func (r scopedRouter) HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	return r.router.HEAD(path, h, r.with(m)...)
+}
+
+func (r scopedRouter) OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	return r.router.OPTIONS(path, h, r.with(m)...)
+}
+
+func (r scopedRouter) PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	return r.router.PATCH(path, h, r.with(m)...)
+}
+
+func (r scopedRouter) POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	return r.router.POST(path, h, r.with(m)...)
+}
+
+func (r scopedRouter) PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	return r.router.PUT(path, h, r.with(m)...)
+}
+
+func (r scopedRouter) TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	return r.router.TRACE(path, h, r.with(m)...)
+}
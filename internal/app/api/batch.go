@@ -0,0 +1,302 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/logicrunner/builtin/foundation"
+	"github.com/insolar/mainnet/application/appfoundation"
+	"github.com/labstack/echo/v4"
+
+	"github.com/insolar/observer/component"
+	"github.com/insolar/observer/configuration"
+	"github.com/insolar/observer/internal/dbconn"
+	"github.com/insolar/observer/internal/models"
+)
+
+const (
+	BatchMembersPath      = "/api/batch/members"
+	BatchBalancesPath     = "/api/batch/balances"
+	BatchTransactionsPath = "/api/batch/transactions"
+
+	// Streamed response is flushed after every this many items.
+	batchFlushItems = 100
+
+	batchNotFound = "not found"
+)
+
+// BatchPaths only read though they are POST, so they are allowed in readonly mode.
+var BatchPaths = []string{BatchMembersPath, BatchBalancesPath, BatchTransactionsPath}
+
+// Batch looks up many members or transactions at once, every kind of key is fetched with one query.
+type Batch struct {
+	db    *dbconn.Pool
+	log   insolar.Logger
+	limit int
+}
+
+// BatchRequest lists member references, migration addresses or public keys, or transaction IDs.
+type BatchRequest struct {
+	Items []string `json:"items"`
+}
+
+// BatchItem is the result for one of the requested items, they are returned in the request order.
+// Error is set instead of result if the item is invalid or not found.
+type BatchItem struct {
+	Item   string      `json:"item"`
+	Result interface{} `json:"result,omitempty"`
+	Error  string      `json:"error,omitempty"`
+}
+
+func NewBatch(db *dbconn.Pool, log insolar.Logger, config configuration.APIConfig) *Batch {
+	return &Batch{db: db, log: log, limit: config.GetBatchLimit()}
+}
+
+func RegisterBatchHandlers(router runtime.EchoRouter, b *Batch) {
+	router.POST(BatchMembersPath, b.Members)
+	router.POST(BatchBalancesPath, b.Balances)
+	router.POST(BatchTransactionsPath, b.Transactions)
+}
+
+// Members responds with members like `/api/member/{reference}` and `/api/member/byPublicKey`.
+func (b *Batch) Members(ctx echo.Context) error {
+	items, errMsg := b.read(ctx)
+	if errMsg != nil {
+		return ctx.JSON(http.StatusBadRequest, *errMsg)
+	}
+	found, err := b.members(ctx.Request().Context(), items)
+	if err != nil {
+		b.log.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, struct{}{})
+	}
+
+	var refs [][]byte
+	for _, f := range found {
+		if f.member != nil {
+			refs = append(refs, f.member.Reference)
+		}
+	}
+	deposits := make(map[string][]models.Deposit)
+	if len(refs) > 0 {
+		all, err := component.GetMembersDeposits(ctx.Request().Context(), b.db.Read(), refs, true)
+		if err != nil {
+			b.log.Error(err)
+			return ctx.JSON(http.StatusInternalServerError, struct{}{})
+		}
+		for _, d := range all {
+			deposits[string(d.MemberReference)] = append(deposits[string(d.MemberReference)], d)
+		}
+	}
+	var burnedBalance *models.BurnedBalance
+	if hasMember(found, appfoundation.GetMigrationAdminMember()) {
+		burnedBalance, err = component.GetBurnedBalance(b.db.Read())
+		if err != nil {
+			b.log.Error(err)
+			return ctx.JSON(http.StatusInternalServerError, struct{}{})
+		}
+	}
+
+	return streamBatch(ctx, len(items), func(i int) BatchItem {
+		f := found[i]
+		if f.member == nil {
+			return BatchItem{Item: items[i], Error: f.err}
+		}
+		var burned *models.BurnedBalance
+		if insolar.NewReferenceFromBytes(f.member.Reference).Equal(appfoundation.GetMigrationAdminMember()) {
+			burned = burnedBalance
+		}
+		res, err := MemberToAPIMember(*f.member, deposits[string(f.member.Reference)], burned, f.method != getByReference)
+		if err != nil {
+			b.log.Error(err)
+			return BatchItem{Item: items[i], Error: "internal error"}
+		}
+		return BatchItem{Item: items[i], Result: res}
+	})
+}
+
+// Balances responds with balances like `/api/member/{reference}/balance`, members can be found by the same keys as in Members.
+func (b *Batch) Balances(ctx echo.Context) error {
+	items, errMsg := b.read(ctx)
+	if errMsg != nil {
+		return ctx.JSON(http.StatusBadRequest, *errMsg)
+	}
+	found, err := b.members(ctx.Request().Context(), items)
+	if err != nil {
+		b.log.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, struct{}{})
+	}
+	return streamBatch(ctx, len(items), func(i int) BatchItem {
+		if found[i].member == nil {
+			return BatchItem{Item: items[i], Error: found[i].err}
+		}
+		return BatchItem{Item: items[i], Result: ResponsesMemberBalanceYaml{Balance: found[i].member.Balance}}
+	})
+}
+
+// Transactions responds with registered transactions like `/api/transaction/{txID}`.
+func (b *Batch) Transactions(ctx echo.Context) error {
+	items, errMsg := b.read(ctx)
+	if errMsg != nil {
+		return ctx.JSON(http.StatusBadRequest, *errMsg)
+	}
+	ids := make([][]byte, len(items))
+	var valid [][]byte
+	for i, item := range items {
+		txID, err := insolar.NewRecordReferenceFromString(strings.TrimSpace(item))
+		if err != nil {
+			continue
+		}
+		ids[i] = txID.Bytes()
+		valid = append(valid, ids[i])
+	}
+	txs := make(map[string]models.Transaction)
+	if len(valid) > 0 {
+		found, err := component.GetTxs(ctx.Request().Context(), b.db.Read(), valid)
+		if err != nil {
+			b.log.Error(err)
+			return ctx.JSON(http.StatusInternalServerError, struct{}{})
+		}
+		if err := component.SetPulseDates(ctx.Request().Context(), b.db.Read(), found); err != nil {
+			b.log.Error(err)
+			return ctx.JSON(http.StatusInternalServerError, struct{}{})
+		}
+		for _, tx := range found {
+			txs[string(tx.TransactionID)] = tx
+		}
+	}
+	return streamBatch(ctx, len(items), func(i int) BatchItem {
+		if ids[i] == nil {
+			return BatchItem{Item: items[i], Error: "tx_id wrong format"}
+		}
+		tx, ok := txs[string(ids[i])]
+		if !ok {
+			return BatchItem{Item: items[i], Error: batchNotFound}
+		}
+		return BatchItem{Item: items[i], Result: TxToAPITx(tx, models.TxIndexTypePulseRecord)}
+	})
+}
+
+func (b *Batch) read(ctx echo.Context) ([]string, *ErrorMessage) {
+	req := BatchRequest{}
+	if err := json.NewDecoder(ctx.Request().Body).Decode(&req); err != nil {
+		errMsg := NewSingleMessageError("Request body should be a JSON object with `items`.")
+		return nil, &errMsg
+	}
+	if len(req.Items) == 0 || len(req.Items) > b.limit {
+		errMsg := NewSingleMessageError(fmt.Sprintf("`items` should contain from 1 to %d items", b.limit))
+		return nil, &errMsg
+	}
+	return req.Items, nil
+}
+
+type foundMember struct {
+	member *models.Member
+	// getByReference, getByMigrationAddress or getByPublicKey
+	method int
+	err    string
+}
+
+// members finds members by references, migration addresses and public keys with a query for every kind.
+func (b *Batch) members(ctx context.Context, items []string) ([]foundMember, error) {
+	found := make([]foundMember, len(items))
+	keys := make([]string, len(items))
+	var refs [][]byte
+	var addresses, publicKeys []string
+	for i, item := range items {
+		item = strings.TrimSpace(item)
+		if ref, err := insolar.NewReferenceFromString(item); err == nil {
+			found[i].method, keys[i] = getByReference, string(ref.Bytes())
+			refs = append(refs, ref.Bytes())
+			continue
+		}
+		if appfoundation.IsEthereumAddress(item) {
+			found[i].method, keys[i] = getByMigrationAddress, item
+			addresses = append(addresses, item)
+			continue
+		}
+		if publicKey, err := foundation.ExtractCanonicalPublicKey(item); err == nil {
+			found[i].method, keys[i] = getByPublicKey, publicKey
+			publicKeys = append(publicKeys, publicKey)
+			continue
+		}
+		found[i].err = "reference wrong format"
+	}
+
+	byMethod := map[int]map[string]*models.Member{}
+	lookups := []struct {
+		method int
+		column string
+		values interface{}
+		key    func(m *models.Member) string
+		empty  bool
+	}{
+		{getByReference, "member_ref", refs, func(m *models.Member) string { return string(m.Reference) }, len(refs) == 0},
+		{getByMigrationAddress, "migration_address", addresses, func(m *models.Member) string { return m.MigrationAddress }, len(addresses) == 0},
+		{getByPublicKey, "public_key", publicKeys, func(m *models.Member) string { return m.PublicKey }, len(publicKeys) == 0},
+	}
+	for _, l := range lookups {
+		byMethod[l.method] = make(map[string]*models.Member)
+		if l.empty {
+			continue
+		}
+		members, err := component.GetMembersBy(ctx, b.db.Read(), l.column, l.values)
+		if err != nil {
+			return nil, err
+		}
+		for i := range members {
+			byMethod[l.method][l.key(&members[i])] = &members[i]
+		}
+	}
+
+	for i := range found {
+		if found[i].err != "" {
+			continue
+		}
+		found[i].member = byMethod[found[i].method][keys[i]]
+		if found[i].member == nil {
+			found[i].err = batchNotFound
+		}
+	}
+	return found, nil
+}
+
+func hasMember(found []foundMember, ref insolar.Reference) bool {
+	for _, f := range found {
+		if f.member != nil && insolar.NewReferenceFromBytes(f.member.Reference).Equal(ref) {
+			return true
+		}
+	}
+	return false
+}
+
+// streamBatch writes items as a JSON array without building the whole response in memory.
+func streamBatch(ctx echo.Context, n int, item func(i int) BatchItem) error {
+	res := ctx.Response()
+	res.Header().Set(echo.HeaderContentType, echo.MIMEApplicationJSONCharsetUTF8)
+	res.WriteHeader(http.StatusOK)
+	enc := json.NewEncoder(res)
+	if _, err := io.WriteString(res, "["); err != nil {
+		return err
+	}
+	for i := 0; i < n; i++ {
+		if i > 0 {
+			if _, err := io.WriteString(res, ","); err != nil {
+				return err
+			}
+		}
+		if err := enc.Encode(item(i)); err != nil {
+			return err
+		}
+		if (i+1)%batchFlushItems == 0 {
+			res.Flush()
+		}
+	}
+	_, err := io.WriteString(res, "]")
+	return err
+}
@@ -0,0 +1,103 @@
+package api
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/observer/internal/models"
+)
+
+type batchItem struct {
+	Item   string          `json:"item"`
+	Result json.RawMessage `json:"result"`
+	Error  string          `json:"error"`
+}
+
+func postBatch(t *testing.T, path string, body string) []batchItem {
+	resp, err := http.Post("http://"+apihost+path, "application/json", strings.NewReader(body))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	bodyBytes, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	var received []batchItem
+	require.NoError(t, json.Unmarshal(bodyBytes, &received))
+	return received
+}
+
+func TestBatch_BadRequest(t *testing.T) {
+	for _, body := range []string{`{"items": []}`, `[]`, `{"items": [` + strings.Repeat(`"a",`, 1000) + `"a"]}`} {
+		resp, err := http.Post("http://"+apihost+BatchMembersPath, "application/json", strings.NewReader(body))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	}
+}
+
+func TestBatchMembers(t *testing.T) {
+	defer truncateDB(t)
+
+	member := gen.Reference()
+	wallet := gen.Reference()
+	account := gen.Reference()
+	deposit := gen.Reference()
+	insertMember(t, member, &wallet, &account, "1000", randomString())
+	insertDeposit(t, deposit, member, "10000", "1000", "eth_hash_1", 1, models.DepositStatusConfirmed)
+	missing := gen.Reference()
+
+	received := postBatch(t, BatchMembersPath,
+		`{"items": ["`+missing.String()+`", "not_valid_ref", "`+member.String()+`", "`+notExistedMigrationAddress+`"]}`)
+	require.Len(t, received, 4)
+
+	require.Equal(t, missing.String(), received[0].Item)
+	require.Equal(t, "not found", received[0].Error)
+	require.Equal(t, "reference wrong format", received[1].Error)
+	require.Equal(t, "not found", received[3].Error)
+
+	require.Empty(t, received[2].Error)
+	res := ResponsesMemberYaml{}
+	require.NoError(t, json.Unmarshal(received[2].Result, &res))
+	require.Equal(t, account.String(), res.AccountReference)
+	require.Equal(t, "1000", res.Balance)
+	require.NotNil(t, res.Deposits)
+	require.Len(t, *res.Deposits, 1)
+	require.Equal(t, deposit.String(), (*res.Deposits)[0].DepositReference)
+}
+
+func TestBatchBalances(t *testing.T) {
+	defer truncateDB(t)
+
+	member1 := gen.Reference()
+	member2 := gen.Reference()
+	insertMember(t, member1, nil, nil, "1234567", randomString())
+	insertMember(t, member2, nil, nil, "567890", randomString())
+
+	received := postBatch(t, BatchBalancesPath, `{"items": ["`+member2.String()+`", "`+member1.String()+`"]}`)
+	require.Len(t, received, 2)
+	require.JSONEq(t, `{"balance": "567890"}`, string(received[0].Result))
+	require.JSONEq(t, `{"balance": "1234567"}`, string(received[1].Result))
+}
+
+func TestBatchTransactions(t *testing.T) {
+	defer truncateDB(t)
+
+	txID := gen.RecordReference()
+	pulseNumber := gen.PulseNumber()
+	insertTransaction(t, txID.Bytes(), int64(pulseNumber), int64(pulseNumber)+10, 1234)
+	missing := gen.RecordReference()
+
+	received := postBatch(t, BatchTransactionsPath,
+		`{"items": ["`+txID.String()+`", "`+missing.String()+`", "not_valid_tx"]}`)
+	require.Len(t, received, 3)
+
+	require.Empty(t, received[0].Error)
+	res := SchemasTransactionAbstract{}
+	require.NoError(t, json.Unmarshal(received[0].Result, &res))
+	require.Equal(t, txID.String(), res.TxID)
+	require.Equal(t, "not found", received[1].Error)
+	require.Equal(t, "tx_id wrong format", received[2].Error)
+}
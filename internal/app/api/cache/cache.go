@@ -0,0 +1,229 @@
+// Package cache keeps API responses until the observer stores a new pulse.
+package cache
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-pg/pg"
+	"github.com/insolar/insolar/insolar"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/insolar/observer/configuration"
+	"github.com/insolar/observer/internal/dbconn"
+)
+
+const (
+	resultHit   = "hit"
+	resultMiss  = "miss"
+	resultError = "error"
+
+	// Used if Size, TTL or PollInterval aren't set, without TTL responses would never be stored in memory
+	// and never expire in Redis.
+	defaultSize         = 10000
+	defaultTTL          = 10 * time.Second
+	defaultPollInterval = time.Second
+)
+
+// Headers that depend on the time of the response aren't cached, Expires is restored from Cache-Control.
+var skippedHeaders = map[string]bool{
+	"Date":          true,
+	"Expires":       true,
+	"Set-Cookie":    true,
+	"Etag":          true,
+	"Last-Modified": true,
+}
+
+var maxAgeRe = regexp.MustCompile(`max-age=(\d+)`)
+
+// Store keeps encoded responses for ttl, keys of older pulses are dropped on Purge.
+type Store interface {
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	Purge()
+}
+
+// Cache serves GET requests to the configured routes from the store. Keys include the last stored pulse,
+// so a new pulse makes all responses stale at once, in every API instance sharing the store.
+// The pulse is polled from db because the observer doesn't notify about pulses.
+type Cache struct {
+	db    *dbconn.Pool
+	log   insolar.Logger
+	cfg   configuration.ResponseCache
+	store Store
+
+	pulse uint32
+
+	requests *prometheus.CounterVec
+}
+
+func New(db *dbconn.Pool, log insolar.Logger, cfg configuration.ResponseCache) *Cache {
+	if cfg.Size <= 0 {
+		cfg.Size = defaultSize
+	}
+	if cfg.TTL <= 0 {
+		cfg.TTL = defaultTTL
+	}
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = defaultPollInterval
+	}
+	c := &Cache{
+		db:  db,
+		log: log,
+		cfg: cfg,
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "observer_api_cache_requests_total",
+			Help: "Cacheable API requests by route and cache result",
+		}, []string{"route", "result"}),
+	}
+	switch {
+	case !cfg.Enabled:
+	case cfg.Redis != "":
+		c.store = newRedisStore(cfg.Redis)
+	default:
+		c.store = newMemoryStore(cfg.Size)
+	}
+	return c
+}
+
+// Collector exports hits and misses.
+func (c *Cache) Collector() prometheus.Collector {
+	return c.requests
+}
+
+// Run polls the last pulse until ctx is done.
+func (c *Cache) Run(ctx context.Context) {
+	if !c.cfg.Enabled {
+		return
+	}
+	ticker := time.NewTicker(c.cfg.PollInterval)
+	defer ticker.Stop()
+	for {
+		// Replicas lag behind differently, the pulse read from them in turns would go back and forth.
+		var pulse uint32
+		_, err := c.db.Primary().QueryOneContext(ctx, pg.Scan(&pulse), `select coalesce(max(pulse), 0) from pulses`)
+		if err != nil {
+			c.log.Error(errors.Wrap(err, "failed to get last pulse"))
+		} else {
+			c.advance(pulse)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (c *Cache) advance(pulse uint32) {
+	if atomic.SwapUint32(&c.pulse, pulse) != pulse {
+		c.store.Purge()
+	}
+}
+
+// Cache responds from the store or saves 200 and 204 responses of the handler.
+// It must run after authentication and rate limiting, cached responses are the same for all clients.
+func (c *Cache) Cache(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		if !c.cfg.Enabled || ctx.Request().Method != http.MethodGet || !c.cached(ctx.Path()) {
+			return next(ctx)
+		}
+		key := fmt.Sprintf("%d:%s", atomic.LoadUint32(&c.pulse), ctx.Request().URL.RequestURI())
+		reqCtx := ctx.Request().Context()
+
+		value, ok, err := c.store.Get(reqCtx, key)
+		if err != nil {
+			c.log.Error(errors.Wrap(err, "failed to get cached response"))
+			c.requests.WithLabelValues(ctx.Path(), resultError).Inc()
+		}
+		if ok {
+			e := entry{}
+			if err := json.Unmarshal(value, &e); err == nil {
+				c.requests.WithLabelValues(ctx.Path(), resultHit).Inc()
+				return e.write(ctx)
+			}
+		}
+		c.requests.WithLabelValues(ctx.Path(), resultMiss).Inc()
+
+		res := ctx.Response()
+		original := res.Writer
+		rec := &recorder{ResponseWriter: original, status: http.StatusOK}
+		res.Writer = rec
+		err = next(ctx)
+		res.Writer = original
+		if err != nil || (rec.status != http.StatusOK && rec.status != http.StatusNoContent) {
+			return err
+		}
+
+		e := entry{Status: rec.status, Header: http.Header{}, Body: rec.body.Bytes()}
+		for name, values := range res.Header() {
+			if !skippedHeaders[name] {
+				e.Header[name] = values
+			}
+		}
+		value, err = json.Marshal(e)
+		if err != nil {
+			return errors.Wrap(err, "failed to encode response")
+		}
+		if err := c.store.Set(reqCtx, key, value, c.cfg.TTL); err != nil {
+			c.log.Error(errors.Wrap(err, "failed to cache response"))
+		}
+		return nil
+	}
+}
+
+func (c *Cache) cached(path string) bool {
+	for _, route := range c.cfg.Routes {
+		if path == route || (strings.HasSuffix(route, "*") && strings.HasPrefix(path, strings.TrimSuffix(route, "*"))) {
+			return true
+		}
+	}
+	return false
+}
+
+type entry struct {
+	Status int         `json:"status"`
+	Header http.Header `json:"header"`
+	Body   []byte      `json:"body"`
+}
+
+func (e entry) write(ctx echo.Context) error {
+	header := ctx.Response().Header()
+	for name, values := range e.Header {
+		header[name] = values
+	}
+	if m := maxAgeRe.FindStringSubmatch(header.Get("Cache-Control")); m != nil {
+		seconds, _ := strconv.Atoi(m[1])
+		header.Set("Expires", time.Now().UTC().Add(time.Duration(seconds)*time.Second).Format(http.TimeFormat))
+	}
+	ctx.Response().WriteHeader(e.Status)
+	_, err := ctx.Response().Write(e.Body)
+	return err
+}
+
+// recorder passes the response through and keeps a copy of it.
+type recorder struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (r *recorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *recorder) Write(b []byte) (int, error) {
+	r.body.Write(b)
+	return r.ResponseWriter.Write(b)
+}
@@ -0,0 +1,136 @@
+package cache
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/observer/configuration"
+)
+
+func TestCache(t *testing.T) {
+	c := New(nil, inslogger.FromContext(context.Background()), configuration.ResponseCache{
+		Enabled: true,
+		Routes:  []string{"/data/:id", "/stats/*"},
+		Size:    10,
+		TTL:     time.Minute,
+	})
+	calls := 0
+	handler := func(ctx echo.Context) error {
+		calls++
+		ctx.Response().Header().Set("Cache-Control", "max-age=10")
+		ctx.Response().Header().Set("Expires", "Thu, 01 Jan 1970 00:00:00 GMT")
+		if ctx.QueryParam("fail") != "" {
+			return ctx.JSON(http.StatusInternalServerError, struct{}{})
+		}
+		return ctx.String(http.StatusOK, "body")
+	}
+	e := echo.New()
+	e.GET("/data/:id", handler, c.Cache)
+	e.GET("/stats/network", handler, c.Cache)
+	e.GET("/other", handler, c.Cache)
+	srv := httptest.NewServer(e)
+	defer srv.Close()
+
+	get := func(path string) *http.Response {
+		resp, err := http.Get(srv.URL + path)
+		require.NoError(t, err)
+		body, err := ioutil.ReadAll(resp.Body)
+		require.NoError(t, err)
+		if resp.StatusCode == http.StatusOK {
+			require.Equal(t, "body", string(body))
+		}
+		return resp
+	}
+
+	get("/data/1")
+	resp := get("/data/1")
+	require.Equal(t, 1, calls)
+	require.Equal(t, "max-age=10", resp.Header.Get("Cache-Control"))
+	expires, err := http.ParseTime(resp.Header.Get("Expires"))
+	require.NoError(t, err)
+	require.True(t, expires.After(time.Now()))
+
+	get("/data/2")
+	get("/stats/network")
+	get("/stats/network")
+	require.Equal(t, 3, calls)
+
+	c.advance(1)
+	get("/data/1")
+	require.Equal(t, 4, calls)
+
+	get("/other")
+	get("/other")
+	require.Equal(t, 6, calls)
+
+	get("/data/1?fail=1")
+	resp = get("/data/1?fail=1")
+	require.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	require.Equal(t, 8, calls)
+}
+
+func TestMemoryStore_TTL(t *testing.T) {
+	s := newMemoryStore(2)
+	ctx := context.Background()
+	require.NoError(t, s.Set(ctx, "a", []byte("1"), time.Minute))
+	require.NoError(t, s.Set(ctx, "b", []byte("2"), -time.Second))
+
+	v, ok, err := s.Get(ctx, "a")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, "1", string(v))
+
+	_, ok, _ = s.Get(ctx, "b")
+	require.False(t, ok)
+
+	s.Purge()
+	_, ok, _ = s.Get(ctx, "a")
+	require.False(t, ok)
+}
+
+func TestNew_Defaults(t *testing.T) {
+	c := New(nil, inslogger.FromContext(context.Background()), configuration.ResponseCache{Enabled: true})
+	require.Equal(t, defaultSize, c.cfg.Size)
+	require.Equal(t, defaultTTL, c.cfg.TTL)
+	require.Equal(t, defaultPollInterval, c.cfg.PollInterval)
+	require.NoError(t, c.store.Set(context.Background(), "1:/data/1", []byte("body"), time.Minute))
+}
+
+func TestNew_NoTTL(t *testing.T) {
+	redis, sets := fakeRedis(t)
+	for name, addr := range map[string]string{"memory": "", "redis": redis} {
+		t.Run(name, func(t *testing.T) {
+			c := New(nil, inslogger.FromContext(context.Background()), configuration.ResponseCache{
+				Enabled: true,
+				Routes:  []string{"/data"},
+				Redis:   addr,
+			})
+			calls := 0
+			e := echo.New()
+			e.GET("/data", func(ctx echo.Context) error {
+				calls++
+				return ctx.String(http.StatusOK, "body")
+			}, c.Cache)
+			for i := 0; i < 2; i++ {
+				rec := httptest.NewRecorder()
+				e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/data", nil))
+				require.Equal(t, http.StatusOK, rec.Code)
+			}
+			require.Equal(t, 1, calls)
+
+			if addr != "" {
+				// Responses expire in Redis too, Purge doesn't drop them.
+				args := <-sets
+				require.Equal(t, []string{"ex", "10"}, args[3:])
+			}
+		})
+	}
+}
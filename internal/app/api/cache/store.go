@@ -0,0 +1,90 @@
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-redis/redis"
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/pkg/errors"
+)
+
+type memoryEntry struct {
+	value   []byte
+	expires time.Time
+}
+
+// memoryStore keeps the last used responses of this instance.
+type memoryStore struct {
+	lru *lru.Cache
+}
+
+func newMemoryStore(size int) *memoryStore {
+	cache, err := lru.New(size)
+	if err != nil {
+		panic(errors.Wrap(err, "invalid response cache size"))
+	}
+	return &memoryStore{lru: cache}
+}
+
+func (s *memoryStore) Get(_ context.Context, key string) ([]byte, bool, error) {
+	v, ok := s.lru.Get(key)
+	if !ok {
+		return nil, false, nil
+	}
+	e := v.(memoryEntry)
+	if time.Now().After(e.expires) {
+		s.lru.Remove(key)
+		return nil, false, nil
+	}
+	return e.value, true, nil
+}
+
+func (s *memoryStore) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	s.lru.Add(key, memoryEntry{value: value, expires: time.Now().Add(ttl)})
+	return nil
+}
+
+func (s *memoryStore) Purge() {
+	s.lru.Purge()
+}
+
+const (
+	redisTimeout = time.Second
+	redisIdle    = 16
+	redisPrefix  = "observer:response:"
+)
+
+// redisStore shares responses through a Redis server.
+// Responses of older pulses aren't read anymore and expire by ttl, so Purge does nothing.
+type redisStore struct {
+	client *redis.Client
+}
+
+func newRedisStore(addr string) *redisStore {
+	return &redisStore{client: redis.NewClient(&redis.Options{
+		Addr:         addr,
+		DialTimeout:  redisTimeout,
+		ReadTimeout:  redisTimeout,
+		WriteTimeout: redisTimeout,
+		PoolSize:     redisIdle,
+	})}
+}
+
+func (s *redisStore) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	v, err := s.client.WithContext(ctx).Get(redisPrefix + key).Bytes()
+	if err == redis.Nil {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, errors.Wrap(err, "failed to get from redis")
+	}
+	return v, true, nil
+}
+
+func (s *redisStore) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	err := s.client.WithContext(ctx).Set(redisPrefix+key, value, ttl).Err()
+	return errors.Wrap(err, "failed to set in redis")
+}
+
+func (s *redisStore) Purge() {}
@@ -0,0 +1,89 @@
+package cache
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRedis answers GET and SET of a single client, arguments of SET are sent to the channel.
+func fakeRedis(t *testing.T) (string, <-chan []string) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	sets := make(chan []string, 10)
+	go func() {
+		conn, err := lis.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		r := bufio.NewReader(conn)
+		values := map[string]string{}
+		for {
+			args, err := readCommand(r)
+			if err != nil {
+				return
+			}
+			switch strings.ToUpper(args[0]) {
+			case "SET":
+				values[args[1]] = args[2]
+				sets <- args
+				_, _ = conn.Write([]byte("+OK\r\n"))
+			case "GET":
+				v, ok := values[args[1]]
+				if !ok {
+					_, _ = conn.Write([]byte("$-1\r\n"))
+					continue
+				}
+				_, _ = fmt.Fprintf(conn, "$%d\r\n%s\r\n", len(v), v)
+			default:
+				_, _ = conn.Write([]byte("-ERR unknown command\r\n"))
+			}
+		}
+	}()
+	return lis.Addr().String(), sets
+}
+
+// readCommand reads an array of bulk strings.
+func readCommand(r *bufio.Reader) ([]string, error) {
+	var n int
+	if _, err := fmt.Fscanf(r, "*%d\r\n", &n); err != nil {
+		return nil, err
+	}
+	args := make([]string, n)
+	for i := range args {
+		var size int
+		if _, err := fmt.Fscanf(r, "$%d\r\n", &size); err != nil {
+			return nil, err
+		}
+		b := make([]byte, size+2)
+		if _, err := io.ReadFull(r, b); err != nil {
+			return nil, err
+		}
+		args[i] = string(b[:size])
+	}
+	return args, nil
+}
+
+func TestRedisStore(t *testing.T) {
+	addr, _ := fakeRedis(t)
+	s := newRedisStore(addr)
+	ctx := context.Background()
+
+	_, ok, err := s.Get(ctx, "1:/api/member/x")
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	require.NoError(t, s.Set(ctx, "1:/api/member/x", []byte("{\"body\":\"\\r\\n\"}"), time.Minute))
+	v, ok, err := s.Get(ctx, "1:/api/member/x")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, "{\"body\":\"\\r\\n\"}", string(v))
+}
@@ -0,0 +1,239 @@
+package api
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/go-pg/pg/orm"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/insolar/observer/component"
+	"github.com/insolar/observer/internal/models"
+)
+
+const (
+	orderChronological = "chronological"
+	orderReverse       = "reverse"
+)
+
+var errCursorMismatch = errors.New("Query parameter 'cursor' was issued for a different query.") // nolint
+
+// Cursor is a position in a transaction list bound to the query it was issued for.
+type Cursor struct {
+	// Endpoint and its filters, see pager.query.
+	Query  string `json:"q"`
+	Pulse  int64  `json:"p"`
+	Record int64  `json:"r"`
+	// Backward cursor points to the previous page.
+	Backward bool `json:"b,omitempty"`
+}
+
+// CursorCodec signs cursors so clients can't forge positions or reuse them with other filters.
+type CursorCodec struct {
+	key []byte
+}
+
+// NewCursorCodec makes codec with the secret, random key is used if the secret is empty.
+func NewCursorCodec(secret string) (CursorCodec, error) {
+	if secret != "" {
+		return CursorCodec{key: []byte(secret)}, nil
+	}
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return CursorCodec{}, errors.Wrap(err, "failed to generate cursor key")
+	}
+	return CursorCodec{key: key}, nil
+}
+
+func (c CursorCodec) Encode(cur Cursor) string {
+	payload, err := json.Marshal(cur)
+	if err != nil {
+		panic(errors.Wrap(err, "failed to marshal cursor"))
+	}
+	enc := base64.RawURLEncoding
+	return enc.EncodeToString(payload) + "." + enc.EncodeToString(c.sign(payload))
+}
+
+func (c CursorCodec) Decode(s string) (Cursor, error) {
+	invalid := errors.New("Query parameter 'cursor' is invalid.") // nolint
+	parts := strings.Split(s, ".")
+	if len(parts) != 2 {
+		return Cursor{}, invalid
+	}
+	enc := base64.RawURLEncoding
+	payload, err := enc.DecodeString(parts[0])
+	if err != nil {
+		return Cursor{}, invalid
+	}
+	sig, err := enc.DecodeString(parts[1])
+	if err != nil || !hmac.Equal(sig, c.sign(payload)) {
+		return Cursor{}, invalid
+	}
+	cur := Cursor{}
+	if err := json.Unmarshal(payload, &cur); err != nil {
+		return Cursor{}, invalid
+	}
+	return cur, nil
+}
+
+func (c CursorCodec) sign(payload []byte) []byte {
+	mac := hmac.New(sha256.New, c.key)
+	_, _ = mac.Write(payload)
+	return mac.Sum(nil)
+}
+
+// pager pages a transaction list by the `index` or `cursor` query parameters
+// and reports neighbour pages in `X-Next-Cursor`, `X-Prev-Cursor` and `Link` headers.
+// `total=true` adds the `X-Total-Count` header.
+type pager struct {
+	codec     CursorCodec
+	query     string
+	order     string
+	indexType models.TxIndexType
+	limit     int
+
+	positioned bool
+	pulse      int64
+	record     int64
+	backward   bool
+	withTotal  bool
+	total      int
+}
+
+// newPager reads the position from `index` or `cursor`. Filters must contain everything that affects the list,
+// cursor issued for other filters is rejected.
+func newPager(
+	ctx echo.Context, codec CursorCodec, endpoint string, filters url.Values,
+	index, order *string, indexType models.TxIndexType, limit int,
+) (*pager, error) {
+	p := &pager{
+		codec:     codec,
+		order:     orderReverse,
+		indexType: indexType,
+		limit:     limit,
+		withTotal: ctx.QueryParam("total") == "true",
+	}
+	if order != nil {
+		p.order = *order
+	}
+	if p.order != orderReverse && p.order != orderChronological {
+		return nil, errors.New("Query parameter 'order' should be 'reverse' or 'chronological'.") // nolint
+	}
+	filters.Set("order", p.order)
+	p.query = endpoint + "?" + filters.Encode()
+
+	cursor := ctx.QueryParam("cursor")
+	if cursor != "" && index != nil {
+		return nil, errors.New("Query parameters 'cursor' and 'index' can't be used together.") // nolint
+	}
+	if index != nil {
+		pulse, record, err := checkIndex(*index)
+		if err != nil {
+			return nil, err
+		}
+		p.positioned, p.pulse, p.record = true, pulse, record
+	}
+	if cursor != "" {
+		cur, err := codec.Decode(cursor)
+		if err != nil {
+			return nil, err
+		}
+		if cur.Query != p.query {
+			return nil, errCursorMismatch
+		}
+		p.positioned, p.pulse, p.record, p.backward = true, cur.Pulse, cur.Record, cur.Backward
+	}
+	return p, nil
+}
+
+// apply counts the list if requested and adds position, order and limit to the query.
+// One extra row is selected to find out if there is a next page.
+func (p *pager) apply(query *orm.Query) (*orm.Query, error) {
+	if p.withTotal {
+		total, err := query.Copy().Count()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to count transactions")
+		}
+		p.total = total
+	}
+	order := p.order
+	if p.backward {
+		order = orderChronological
+		if p.order == orderChronological {
+			order = orderReverse
+		}
+	}
+	query, err := component.OrderByIndex(query, &order, p.pulse, p.record, p.positioned, p.indexType)
+	if err != nil {
+		return nil, err
+	}
+	return query.Limit(p.limit + 1), nil
+}
+
+// page trims the extra row, restores the order of a backward page and sets pagination headers.
+func (p *pager) page(ctx echo.Context, txs []models.Transaction) []models.Transaction {
+	more := len(txs) > p.limit
+	if more {
+		txs = txs[:p.limit]
+	}
+	if p.backward {
+		for i, j := 0, len(txs)-1; i < j; i, j = i+1, j-1 {
+			txs[i], txs[j] = txs[j], txs[i]
+		}
+	}
+
+	header := ctx.Response().Header()
+	if p.withTotal {
+		header.Set("X-Total-Count", strconv.Itoa(p.total))
+	}
+	if len(txs) == 0 {
+		return txs
+	}
+
+	var links []string
+	// Going forward there is a next page if the extra row was found, and a previous one if we started somewhere.
+	// Going backward it is the other way around.
+	hasNext, hasPrev := more, p.positioned
+	if p.backward {
+		hasNext, hasPrev = true, more
+	}
+	if hasNext {
+		next := p.cursor(txs[len(txs)-1], false)
+		header.Set("X-Next-Cursor", next)
+		links = append(links, p.link(ctx, next, "next"))
+	}
+	if hasPrev {
+		prev := p.cursor(txs[0], true)
+		header.Set("X-Prev-Cursor", prev)
+		links = append(links, p.link(ctx, prev, "prev"))
+	}
+	if len(links) > 0 {
+		header.Set("Link", strings.Join(links, ", "))
+	}
+	return txs
+}
+
+func (p *pager) cursor(tx models.Transaction, backward bool) string {
+	pos := tx.PulseRecord
+	if p.indexType == models.TxIndexTypeFinishPulseRecord {
+		pos = tx.FinishPulseRecord
+	}
+	return p.codec.Encode(Cursor{Query: p.query, Pulse: pos[0], Record: pos[1], Backward: backward})
+}
+
+func (p *pager) link(ctx echo.Context, cursor, rel string) string {
+	u := *ctx.Request().URL
+	q := u.Query()
+	q.Del("index")
+	q.Set("cursor", cursor)
+	u.RawQuery = q.Encode()
+	return fmt.Sprintf("<%s>; rel=%q", u.RequestURI(), rel)
+}
@@ -0,0 +1,31 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursorCodec(t *testing.T) {
+	codec, err := NewCursorCodec("secret")
+	require.NoError(t, err)
+
+	cur := Cursor{Query: "transactions?order=reverse&status=registered", Pulse: 65537, Record: 10, Backward: true}
+	encoded := codec.Encode(cur)
+
+	decoded, err := codec.Decode(encoded)
+	require.NoError(t, err)
+	require.Equal(t, cur, decoded)
+
+	other, err := NewCursorCodec("other")
+	require.NoError(t, err)
+	_, err = other.Decode(encoded)
+	require.Error(t, err, "cursor signed with another key")
+
+	tampered := codec.Encode(Cursor{Query: cur.Query, Pulse: 1})
+	_, err = codec.Decode(encoded[:len(encoded)/2] + tampered[len(tampered)/2:])
+	require.Error(t, err)
+
+	_, err = codec.Decode("not a cursor")
+	require.Error(t, err)
+}
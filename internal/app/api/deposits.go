@@ -0,0 +1,259 @@
+package api
+
+import (
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+	"github.com/insolar/insolar/insolar"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/insolar/observer/component"
+	"github.com/insolar/observer/internal/models"
+)
+
+// Future release steps in a deposit response are limited, the rest is still on hold.
+const maxReleaseSteps = 1000
+
+// DepositServer serves deposits with their release schedules, the endpoints aren't a part of the generated API.
+type DepositServer interface {
+	Deposit(ctx echo.Context, reference string) error
+	MemberDeposits(ctx echo.Context, reference string) error
+	DepositsByEthHash(ctx echo.Context, ethHash string) error
+}
+
+func RegisterDepositHandlers(router runtime.EchoRouter, s DepositServer) {
+	router.GET("/api/deposit/:reference", func(ctx echo.Context) error {
+		return s.Deposit(ctx, ctx.Param("reference"))
+	})
+	router.GET("/api/member/:reference/deposits", func(ctx echo.Context) error {
+		return s.MemberDeposits(ctx, ctx.Param("reference"))
+	})
+	router.GET("/api/deposits/byEthHash/:hash", func(ctx echo.Context) error {
+		return s.DepositsByEthHash(ctx, ctx.Param("hash"))
+	})
+}
+
+// ResponsesDeposit is SchemaDeposit with vesting parameters, future release steps
+// and registered migrations to and releases from the deposit.
+type ResponsesDeposit struct {
+	SchemaDeposit
+	Amount          string              `json:"amount"`
+	Vesting         int64               `json:"vesting"`
+	VestingStep     int64               `json:"vestingStep"`
+	ReleaseSchedule []SchemaNextRelease `json:"releaseSchedule"`
+	Transactions    []interface{}       `json:"transactions"`
+}
+
+// Deposit responds with the deposit in any status.
+func (s *ObserverServer) Deposit(ctx echo.Context, reference string) error {
+	ref, errMsg := s.checkReference(reference)
+	if errMsg != nil {
+		return ctx.JSON(http.StatusBadRequest, *errMsg)
+	}
+	deposit, err := component.GetDeposit(ctx.Request().Context(), s.db.Read(), ref.Bytes())
+	if err != nil {
+		if err == component.ErrReferenceNotFound {
+			return ctx.NoContent(http.StatusNoContent)
+		}
+		s.log.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, struct{}{})
+	}
+	res, err := s.depositsResponse(ctx, []models.Deposit{*deposit})
+	if err != nil {
+		s.log.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, struct{}{})
+	}
+	return ctx.JSON(http.StatusOK, res[0])
+}
+
+// MemberDeposits responds with all deposits of the member, `status` is created or confirmed.
+func (s *ObserverServer) MemberDeposits(ctx echo.Context, reference string) error {
+	ref, errMsg := s.checkReference(reference)
+	if errMsg != nil {
+		return ctx.JSON(http.StatusBadRequest, *errMsg)
+	}
+	status := ctx.QueryParam("status")
+	switch models.DepositStatus(status) {
+	case "", models.DepositStatusCreated, models.DepositStatusConfirmed:
+	default:
+		return ctx.JSON(http.StatusBadRequest, NewSingleMessageError("Query parameter 'status' should be 'created' or 'confirmed'."))
+	}
+
+	deposits, err := component.GetDeposits(ctx.Request().Context(), s.db.Read(), ref.Bytes(), false)
+	if err != nil {
+		s.log.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, struct{}{})
+	}
+	filtered := deposits[:0]
+	for _, d := range deposits {
+		if status == "" || d.InnerStatus == models.DepositStatus(status) {
+			filtered = append(filtered, d)
+		}
+	}
+	res, err := s.depositsResponse(ctx, filtered)
+	if err != nil {
+		s.log.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, struct{}{})
+	}
+	return ctx.JSON(http.StatusOK, res)
+}
+
+// DepositsByEthHash responds with deposits created by the migration with the ethereum transaction hash.
+func (s *ObserverServer) DepositsByEthHash(ctx echo.Context, ethHash string) error {
+	ethHash = strings.TrimSpace(ethHash)
+	if ethHash == "" {
+		return ctx.JSON(http.StatusBadRequest, NewSingleMessageError("empty eth hash"))
+	}
+	deposits, err := component.GetDepositsByEthHash(ctx.Request().Context(), s.db.Read(), ethHash)
+	if err != nil {
+		s.log.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, struct{}{})
+	}
+	if len(deposits) == 0 {
+		return ctx.NoContent(http.StatusNoContent)
+	}
+	res, err := s.depositsResponse(ctx, deposits)
+	if err != nil {
+		s.log.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, struct{}{})
+	}
+	return ctx.JSON(http.StatusOK, res)
+}
+
+// depositsResponse fetches transactions of all deposits with one query.
+func (s *ObserverServer) depositsResponse(ctx echo.Context, deposits []models.Deposit) ([]ResponsesDeposit, error) {
+	res := make([]ResponsesDeposit, 0, len(deposits))
+	if len(deposits) == 0 {
+		return res, nil
+	}
+	refs := make([][]byte, 0, len(deposits))
+	for _, d := range deposits {
+		refs = append(refs, d.Reference)
+	}
+	txs, err := component.GetDepositsTxs(ctx.Request().Context(), s.db.Read(), refs)
+	if err != nil {
+		return nil, err
+	}
+	if err := component.SetPulseDates(ctx.Request().Context(), s.db.Read(), txs); err != nil {
+		return nil, err
+	}
+	byDeposit := make(map[string][]interface{})
+	for _, tx := range txs {
+		apiTx := TxToAPITx(tx, models.TxIndexTypePulseRecord)
+		if len(tx.DepositToReference) > 0 {
+			byDeposit[string(tx.DepositToReference)] = append(byDeposit[string(tx.DepositToReference)], apiTx)
+		}
+		if len(tx.DepositFromReference) > 0 {
+			byDeposit[string(tx.DepositFromReference)] = append(byDeposit[string(tx.DepositFromReference)], apiTx)
+		}
+	}
+
+	now := time.Now().Unix()
+	for _, d := range deposits {
+		deposit, err := DepositToAPIDeposit(d, now)
+		if err != nil {
+			return nil, err
+		}
+		deposit.Transactions = byDeposit[string(d.Reference)]
+		if deposit.Transactions == nil {
+			deposit.Transactions = []interface{}{}
+		}
+		res = append(res, deposit)
+	}
+	return res, nil
+}
+
+// DepositToAPIDeposit computes amounts and status of the deposit at the `now` unix timestamp.
+// Created deposits are in MIGRATION, confirmed ones are LOCKED until the first release step.
+func DepositToAPIDeposit(d models.Deposit, now int64) (ResponsesDeposit, error) {
+	amount := new(big.Int)
+	if _, err := fmt.Sscan(d.Amount, amount); err != nil {
+		return ResponsesDeposit{}, errors.Wrap(err, "failed to parse deposit amount")
+	}
+	balance := new(big.Int)
+	if _, err := fmt.Sscan(d.Balance, balance); err != nil {
+		return ResponsesDeposit{}, errors.Wrap(err, "failed to parse deposit balance")
+	}
+
+	released, schedule := ReleaseSchedule(amount, d.HoldReleaseDate, d.Vesting, d.VestingStep, now)
+	res := ResponsesDeposit{
+		SchemaDeposit: SchemaDeposit{
+			AmountOnHold:    new(big.Int).Sub(amount, released).Text(10),
+			AvailableAmount: balance.Text(10),
+			EthTxHash:       d.EtheriumHash,
+			HoldReleaseDate: d.HoldReleaseDate,
+			ReleasedAmount:  released.Text(10),
+			ReleaseEndDate:  d.HoldReleaseDate + d.Vesting,
+			Status:          "AVAILABLE",
+			Timestamp:       d.Timestamp,
+		},
+		Amount:          amount.Text(10),
+		Vesting:         d.Vesting,
+		VestingStep:     d.VestingStep,
+		ReleaseSchedule: schedule,
+	}
+	switch {
+	case d.InnerStatus != models.DepositStatusConfirmed:
+		res.Status = "MIGRATION"
+	case released.Sign() == 0 && amount.Sign() > 0:
+		res.Status = "LOCKED"
+	}
+	if d.DepositNumber != nil {
+		res.Index = int(*d.DepositNumber)
+	}
+	if len(schedule) > 0 {
+		res.NextRelease = &schedule[0]
+	}
+	if ref := insolar.NewReferenceFromBytes(d.Reference); ref != nil {
+		res.DepositReference = ref.String()
+	}
+	if len(d.MemberReference) > 0 {
+		res.MemberReference = NullableString(insolar.NewReferenceFromBytes(d.MemberReference).String())
+	}
+	return res, nil
+}
+
+// ReleaseSchedule splits amount into equal steps of vestingStep seconds after holdReleaseDate, the last step
+// is at holdReleaseDate + vesting and gets the remainder. Without vesting everything is released at holdReleaseDate.
+// It returns the amount released by `now` and the following steps.
+func ReleaseSchedule(amount *big.Int, holdReleaseDate, vesting, vestingStep, now int64) (*big.Int, []SchemaNextRelease) {
+	steps := int64(1)
+	if vesting > 0 && vestingStep > 0 {
+		steps = (vesting + vestingStep - 1) / vestingStep
+	} else {
+		vesting, vestingStep = 0, 0
+	}
+	releasedBy := func(step int64) *big.Int {
+		res := new(big.Int).Mul(amount, big.NewInt(step))
+		return res.Quo(res, big.NewInt(steps))
+	}
+	stepTime := func(step int64) int64 {
+		if step == steps {
+			return holdReleaseDate + vesting
+		}
+		return holdReleaseDate + step*vestingStep
+	}
+
+	// The first step that isn't released by now.
+	next := int64(1)
+	if now >= holdReleaseDate+vesting {
+		next = steps + 1
+	} else if vestingStep > 0 && now >= holdReleaseDate {
+		next = (now-holdReleaseDate)/vestingStep + 1
+	}
+
+	released := releasedBy(next - 1)
+	schedule := []SchemaNextRelease{}
+	for step := next; step <= steps && len(schedule) < maxReleaseSteps; step++ {
+		schedule = append(schedule, SchemaNextRelease{
+			Amount:    new(big.Int).Sub(releasedBy(step), releasedBy(step-1)).Text(10),
+			Timestamp: stepTime(step),
+		})
+	}
+	return released, schedule
+}
@@ -0,0 +1,177 @@
+package api
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"testing"
+
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/observer/internal/models"
+)
+
+func TestReleaseSchedule(t *testing.T) {
+	amount := big.NewInt(1000)
+	tests := []struct {
+		name     string
+		vesting  int64
+		step     int64
+		now      int64
+		released string
+		schedule []SchemaNextRelease
+	}{
+		{
+			name:     "no vesting, on hold",
+			now:      50,
+			released: "0",
+			schedule: []SchemaNextRelease{{Amount: "1000", Timestamp: 100}},
+		},
+		{
+			name:     "no vesting, released",
+			now:      100,
+			released: "1000",
+			schedule: []SchemaNextRelease{},
+		},
+		{
+			name:     "on hold",
+			vesting:  30,
+			step:     10,
+			now:      0,
+			released: "0",
+			schedule: []SchemaNextRelease{
+				{Amount: "333", Timestamp: 110},
+				{Amount: "333", Timestamp: 120},
+				{Amount: "334", Timestamp: 130},
+			},
+		},
+		{
+			name:     "vesting",
+			vesting:  30,
+			step:     10,
+			now:      115,
+			released: "333",
+			schedule: []SchemaNextRelease{
+				{Amount: "333", Timestamp: 120},
+				{Amount: "334", Timestamp: 130},
+			},
+		},
+		{
+			name:     "last step is shorter",
+			vesting:  25,
+			step:     10,
+			now:      120,
+			released: "666",
+			schedule: []SchemaNextRelease{{Amount: "334", Timestamp: 125}},
+		},
+		{
+			name:     "vested",
+			vesting:  30,
+			step:     10,
+			now:      130,
+			released: "1000",
+			schedule: []SchemaNextRelease{},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			released, schedule := ReleaseSchedule(amount, 100, test.vesting, test.step, test.now)
+			require.Equal(t, test.released, released.Text(10))
+			require.Equal(t, test.schedule, schedule)
+		})
+	}
+}
+
+func TestDeposit_WrongFormat(t *testing.T) {
+	resp, err := http.Get("http://" + apihost + "/api/deposit/not_valid_ref")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestDeposit_NoContent(t *testing.T) {
+	resp, err := http.Get("http://" + apihost + "/api/deposit/" + gen.Reference().String())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNoContent, resp.StatusCode)
+}
+
+func TestDeposit(t *testing.T) {
+	defer truncateDB(t)
+
+	member := gen.Reference()
+	deposit := gen.Reference()
+	insertMember(t, member, nil, nil, "0", randomString())
+	insertDeposit(t, deposit, member, "10000", "1000", "eth_hash_1", 1, models.DepositStatusCreated)
+
+	txID := gen.RecordReference()
+	pulseNumber := gen.PulseNumber()
+	tx := transactionModel(txID.Bytes(), int64(pulseNumber))
+	tx.Type = models.TTypeMigration
+	tx.DepositToReference = deposit.Bytes()
+	require.NoError(t, db.Insert(tx))
+
+	resp, err := http.Get("http://" + apihost + "/api/deposit/" + deposit.String())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	bodyBytes, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	received := struct {
+		ResponsesDeposit
+		Transactions []SchemasTransactionAbstract `json:"transactions"`
+	}{}
+	require.NoError(t, json.Unmarshal(bodyBytes, &received))
+
+	require.Equal(t, deposit.String(), received.DepositReference)
+	require.Equal(t, member.String(), *received.MemberReference)
+	require.Equal(t, "MIGRATION", received.Status)
+	require.Equal(t, "10000", received.Amount)
+	require.Len(t, received.Transactions, 1)
+	require.Equal(t, txID.String(), received.Transactions[0].TxID)
+}
+
+func TestMemberDeposits(t *testing.T) {
+	defer truncateDB(t)
+
+	member := gen.Reference()
+	insertMember(t, member, nil, nil, "0", randomString())
+	insertDeposit(t, gen.Reference(), member, "10000", "1000", "eth_hash_1", 1, models.DepositStatusConfirmed)
+	insertDeposit(t, gen.Reference(), member, "2000", "2000", "eth_hash_2", 2, models.DepositStatusCreated)
+
+	resp, err := http.Get("http://" + apihost + "/api/member/" + member.String() + "/deposits?status=confirmed")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	bodyBytes, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	var received []ResponsesDeposit
+	require.NoError(t, json.Unmarshal(bodyBytes, &received))
+	require.Len(t, received, 1)
+	require.Equal(t, "eth_hash_1", received[0].EthTxHash)
+
+	resp, err = http.Get("http://" + apihost + "/api/member/" + member.String() + "/deposits?status=unknown")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestDepositsByEthHash(t *testing.T) {
+	defer truncateDB(t)
+
+	member := gen.Reference()
+	deposit := gen.Reference()
+	insertMember(t, member, nil, nil, "0", randomString())
+	insertDeposit(t, deposit, member, "10000", "1000", "eth_hash_1", 1, models.DepositStatusConfirmed)
+
+	resp, err := http.Get("http://" + apihost + "/api/deposits/byEthHash/eth_hash_1")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	bodyBytes, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	var received []ResponsesDeposit
+	require.NoError(t, json.Unmarshal(bodyBytes, &received))
+	require.Len(t, received, 1)
+	require.Equal(t, deposit.String(), received[0].DepositReference)
+
+	resp, err = http.Get("http://" + apihost + "/api/deposits/byEthHash/eth_hash_2")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNoContent, resp.StatusCode)
+}
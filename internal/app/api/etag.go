@@ -0,0 +1,166 @@
+package api
+
+import (
+	"bytes"
+	"fmt"
+	"hash/fnv"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/insolar/observer/internal/dbconn"
+	"github.com/insolar/observer/internal/models"
+)
+
+// lastPulseTTL is how long the last pulse is reused, pulses are much longer.
+const lastPulseTTL = time.Second
+
+// PulsePaths are routes with data the observer derives from pulses, a trailing `*` matches any suffix.
+// Fees, notifications and collected stats change without a new pulse, so other routes don't get Last-Modified.
+var PulsePaths = []string{
+	"/api/member/*",
+	"/api/transaction*",
+	"/api/pulse/*",
+	"/api/deposit*",
+	"/api/record/*",
+	"/api/object/*",
+}
+
+// ConditionalGET adds ETag headers to successful GET responses and responds with 304 Not Modified
+// if the client sends a matching If-None-Match. Responses of PulsePaths also get Last-Modified, which is
+// the time of the last stored pulse, and are checked against If-Modified-Since. Their ETag is made of the last stored
+// pulse and a hash of the body, ETags of other routes are made of the body only.
+// The body is buffered, so streaming and export routes should be passed in `skip`, they are matched by route path.
+func ConditionalGET(db *dbconn.Pool, skip ...string) echo.MiddlewareFunc {
+	last := &lastPulse{db: db}
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			if ctx.Request().Method != http.MethodGet {
+				return next(ctx)
+			}
+			for _, path := range skip {
+				if ctx.Path() == path {
+					return next(ctx)
+				}
+			}
+
+			res := ctx.Response()
+			original := res.Writer
+			buf := &bufferedWriter{ResponseWriter: original, status: http.StatusOK}
+			res.Writer = buf
+			err := next(ctx)
+			res.Writer = original
+			if err != nil && !res.Committed {
+				return err
+			}
+
+			if buf.status == http.StatusOK {
+				header := res.Header()
+				sum := fnv.New64a()
+				_, _ = sum.Write(buf.body.Bytes())
+				etag := fmt.Sprintf(`"%x"`, sum.Sum64())
+				var modified time.Time
+				if pulseDerived(ctx.Path()) {
+					var number uint32
+					number, modified = last.get()
+					etag = fmt.Sprintf(`"%d-%x"`, number, sum.Sum64())
+					if !modified.IsZero() && header.Get("Last-Modified") == "" {
+						header.Set("Last-Modified", modified.UTC().Format(http.TimeFormat))
+					}
+				}
+				header.Set("ETag", etag)
+				if notModified(ctx.Request(), etag, modified) {
+					header.Del(echo.HeaderContentType)
+					header.Del(echo.HeaderContentLength)
+					res.Status = http.StatusNotModified
+					original.WriteHeader(http.StatusNotModified)
+					return err
+				}
+			}
+			original.WriteHeader(buf.status)
+			if _, werr := original.Write(buf.body.Bytes()); werr != nil {
+				return errors.Wrap(werr, "failed to write response")
+			}
+			return err
+		}
+	}
+}
+
+func pulseDerived(path string) bool {
+	for _, p := range PulsePaths {
+		if path == p || (strings.HasSuffix(p, "*") && strings.HasPrefix(path, strings.TrimSuffix(p, "*"))) {
+			return true
+		}
+	}
+	return false
+}
+
+// notModified checks If-None-Match and, if it is absent, If-Modified-Since. Zero modified time is never matched.
+func notModified(req *http.Request, etag string, modified time.Time) bool {
+	if match := req.Header.Get("If-None-Match"); match != "" {
+		for _, tag := range strings.Split(match, ",") {
+			tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+			if tag == "*" || tag == etag {
+				return true
+			}
+		}
+		return false
+	}
+	if since := req.Header.Get("If-Modified-Since"); since != "" && !modified.IsZero() {
+		t, err := http.ParseTime(since)
+		return err == nil && !modified.Truncate(time.Second).After(t)
+	}
+	return false
+}
+
+// bufferedWriter keeps the status and the body until the ETag is known.
+type bufferedWriter struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (w *bufferedWriter) WriteHeader(status int) {
+	w.status = status
+}
+
+func (w *bufferedWriter) Write(b []byte) (int, error) {
+	return w.body.Write(b)
+}
+
+// Flush does nothing, the body is written after the handler returns. Streaming routes must be skipped.
+func (w *bufferedWriter) Flush() {}
+
+// lastPulse caches the last stored pulse for lastPulseTTL.
+type lastPulse struct {
+	db *dbconn.Pool
+
+	mu      sync.Mutex
+	fetched time.Time
+	number  uint32
+	time    time.Time
+}
+
+// get returns zeros if there are no pulses or they can't be read, the ETag is still made of the body then.
+func (l *lastPulse) get() (uint32, time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if time.Since(l.fetched) < lastPulseTTL {
+		return l.number, l.time
+	}
+	l.fetched = time.Now()
+	p := models.Pulse{}
+	err := l.db.Read().Model(&p).Column("pulse", "pulse_date").Order("pulse DESC").Limit(1).Select()
+	if err != nil {
+		return l.number, l.time
+	}
+	l.number, l.time = p.Pulse, time.Time{}
+	if p.PulseDate > 0 {
+		l.time = time.Unix(0, p.PulseDate)
+	}
+	return l.number, l.time
+}
@@ -0,0 +1,117 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/pulse"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/observer/internal/dbconn"
+)
+
+func TestConditionalGET(t *testing.T) {
+	e := echo.New()
+	e.Use(ConditionalGET(dbconn.NewPool(db, inslogger.FromContext(context.Background())), "/skipped"))
+	body := "first"
+	e.GET("/data", func(ctx echo.Context) error { return ctx.String(http.StatusOK, body) })
+	e.GET("/skipped", func(ctx echo.Context) error { return ctx.String(http.StatusOK, body) })
+	e.GET("/flushed", func(ctx echo.Context) error {
+		ctx.Response().WriteHeader(http.StatusOK)
+		_, err := ctx.Response().Write([]byte(body))
+		ctx.Response().Flush()
+		return err
+	})
+	srv := httptest.NewServer(e)
+	defer srv.Close()
+
+	get := func(path string, header http.Header) *http.Response {
+		req, err := http.NewRequest(http.MethodGet, srv.URL+path, nil)
+		require.NoError(t, err)
+		req.Header = header
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		return resp
+	}
+
+	resp := get("/data", http.Header{})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	etag := resp.Header.Get("ETag")
+	require.NotEmpty(t, etag)
+
+	resp = get("/data", http.Header{"If-None-Match": {`"other", ` + etag}})
+	require.Equal(t, http.StatusNotModified, resp.StatusCode)
+
+	body = "second"
+	resp = get("/data", http.Header{"If-None-Match": {etag}})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.NotEqual(t, etag, resp.Header.Get("ETag"))
+
+	resp = get("/skipped", http.Header{})
+	require.Empty(t, resp.Header.Get("ETag"))
+
+	resp = get("/flushed", http.Header{})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.NotEmpty(t, resp.Header.Get("ETag"))
+
+	// Only pulse derived routes are checked against If-Modified-Since.
+	resp = get("/data", http.Header{"If-Modified-Since": {time.Now().UTC().Format(http.TimeFormat)}})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Empty(t, resp.Header.Get("Last-Modified"))
+	require.True(t, pulseDerived("/api/member/:reference"))
+	require.True(t, pulseDerived("/api/transactions/closed"))
+	require.False(t, pulseDerived("/api/fee/:amount"))
+	require.False(t, pulseDerived("/api/notifications"))
+}
+
+func TestNotModified(t *testing.T) {
+	modified := time.Date(2020, 1, 1, 10, 0, 0, 500, time.UTC)
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	require.False(t, notModified(req, `"1-a"`, modified))
+
+	req.Header.Set("If-Modified-Since", modified.Format(http.TimeFormat))
+	require.True(t, notModified(req, `"1-a"`, modified))
+	require.False(t, notModified(req, `"1-a"`, modified.Add(time.Second)))
+
+	// If-None-Match takes precedence.
+	req.Header.Set("If-None-Match", `W/"1-b"`)
+	require.False(t, notModified(req, `"1-a"`, modified))
+	req.Header.Set("If-None-Match", "*")
+	require.True(t, notModified(req, `"1-a"`, modified))
+}
+
+func TestClosedTransactions_Immutable(t *testing.T) {
+	defer truncateDB(t)
+
+	for _, p := range []int64{65537, 65547} {
+		tx := transactionModel(gen.RecordReference().Bytes(), p)
+		tx.StatusFinished = true
+		tx.FinishPulseRecord = [2]int64{p, recordNum}
+		require.NoError(t, db.Insert(tx))
+	}
+	last := int64(1575302444 - pulse.UnixTimeOfMinTimePulse + pulse.MinTimePulse)
+
+	get := func(query string) *http.Response {
+		resp, err := http.Get("http://" + apihost + "/api/transactions/closed?" + query)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		return resp
+	}
+	resp := get(fmt.Sprintf("limit=1&order=reverse&index=%d:%d", 65547, recordNum+1))
+	require.Contains(t, resp.Header.Get("Cache-Control"), "immutable")
+
+	// The page isn't full.
+	resp = get(fmt.Sprintf("limit=10&order=reverse&index=%d:%d", 65547, recordNum+1))
+	require.NotContains(t, resp.Header.Get("Cache-Control"), "immutable")
+
+	// The index is after the last stored pulse, the page gets transactions of the next pulses.
+	resp = get(fmt.Sprintf("limit=1&order=reverse&index=%d:0", last+10))
+	require.NotContains(t, resp.Header.Get("Cache-Control"), "immutable")
+}
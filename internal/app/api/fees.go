@@ -0,0 +1,139 @@
+// +build !node
+
+package api
+
+import (
+	"math/big"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+	"github.com/labstack/echo/v4"
+
+	"github.com/insolar/observer/internal/app/api/fees"
+)
+
+// RegisterFeeHandlers adds admin endpoints managing fee schedules, the schedules are keyed by effectiveFrom.
+func RegisterFeeHandlers(router runtime.EchoRouter, s *ObserverServerExtended, auth echo.MiddlewareFunc) {
+	router.GET("/admin/fees", s.FeeSchedules, auth)
+	router.POST("/admin/fees", s.SaveFeeSchedule, auth)
+	router.DELETE("/admin/fees/:effectiveFrom", s.DeleteFeeSchedule, auth)
+}
+
+// FeeSchedule is a set of tiers effective from the unix timestamp.
+type FeeSchedule struct {
+	EffectiveFrom int64     `json:"effectiveFrom"`
+	Tiers         []FeeTier `json:"tiers"`
+}
+
+// FeeTier charges percent of amounts from startSum up to finSum, but not less than minAmount.
+// Empty finSum means the tier has no upper bound.
+type FeeTier struct {
+	StartSum  string  `json:"startSum"`
+	FinSum    *string `json:"finSum,omitempty"`
+	Percent   string  `json:"percent"`
+	MinAmount string  `json:"minAmount"`
+}
+
+// FeeSchedules responds with all schedules including the ones that aren't effective yet.
+func (s *ObserverServerExtended) FeeSchedules(ctx echo.Context) error {
+	schedules, err := s.fees.Schedules(ctx.Request().Context())
+	if err != nil {
+		s.log.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, struct{}{})
+	}
+	res := make([]FeeSchedule, 0, len(schedules))
+	for _, schedule := range schedules {
+		res = append(res, feeScheduleResponse(schedule))
+	}
+	return ctx.JSON(http.StatusOK, res)
+}
+
+// SaveFeeSchedule creates the schedule or replaces the one with the same effectiveFrom.
+func (s *ObserverServerExtended) SaveFeeSchedule(ctx echo.Context) error {
+	req := FeeSchedule{}
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewSingleMessageError("Request body should be a fee schedule."))
+	}
+	schedule, errMsg := checkFeeSchedule(req)
+	if errMsg != nil {
+		return ctx.JSON(http.StatusBadRequest, *errMsg)
+	}
+	if err := s.fees.Save(ctx.Request().Context(), schedule); err != nil {
+		s.log.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, struct{}{})
+	}
+	return ctx.JSON(http.StatusOK, feeScheduleResponse(schedule))
+}
+
+// DeleteFeeSchedule removes the schedule, the fee falls back to the previous schedule or the configured amount.
+func (s *ObserverServerExtended) DeleteFeeSchedule(ctx echo.Context) error {
+	effectiveFrom, err := strconv.ParseInt(ctx.Param("effectiveFrom"), 10, 64)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewSingleMessageError("Path parameter 'effectiveFrom' should be unix timestamp."))
+	}
+	deleted, err := s.fees.Delete(ctx.Request().Context(), time.Unix(effectiveFrom, 0))
+	if err != nil {
+		s.log.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, struct{}{})
+	}
+	if !deleted {
+		return ctx.NoContent(http.StatusNotFound)
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func checkFeeSchedule(req FeeSchedule) (fees.Schedule, *ErrorMessage) {
+	var errorMsg ErrorMessage
+	schedule := fees.Schedule{EffectiveFrom: time.Unix(req.EffectiveFrom, 0)}
+	if req.EffectiveFrom < 0 {
+		errorMsg.Error = append(errorMsg.Error, "Field 'effectiveFrom' should be unix timestamp.")
+	}
+	for _, t := range req.Tiers {
+		var (
+			tier fees.Tier
+			ok   bool
+		)
+		if tier.StartSum, ok = new(big.Int).SetString(t.StartSum, 10); !ok {
+			errorMsg.Error = append(errorMsg.Error, "Field 'startSum' should be an integer.")
+		}
+		if t.FinSum != nil {
+			if tier.FinSum, ok = new(big.Int).SetString(*t.FinSum, 10); !ok {
+				errorMsg.Error = append(errorMsg.Error, "Field 'finSum' should be an integer.")
+			}
+		}
+		if tier.Percent, ok = new(big.Rat).SetString(t.Percent); !ok {
+			errorMsg.Error = append(errorMsg.Error, "Field 'percent' should be a decimal number.")
+		}
+		if tier.MinAmount, ok = new(big.Int).SetString(t.MinAmount, 10); !ok {
+			errorMsg.Error = append(errorMsg.Error, "Field 'minAmount' should be an integer.")
+		}
+		schedule.Tiers = append(schedule.Tiers, tier)
+	}
+	if len(errorMsg.Error) > 0 {
+		return schedule, &errorMsg
+	}
+	if err := schedule.Validate(); err != nil {
+		errorMsg = NewSingleMessageError(err.Error())
+		return schedule, &errorMsg
+	}
+	return schedule, nil
+}
+
+func feeScheduleResponse(schedule fees.Schedule) FeeSchedule {
+	res := FeeSchedule{EffectiveFrom: schedule.EffectiveFrom.Unix(), Tiers: make([]FeeTier, 0, len(schedule.Tiers))}
+	for _, t := range schedule.Tiers {
+		tier := FeeTier{
+			StartSum:  t.StartSum.String(),
+			Percent:   t.PercentString(),
+			MinAmount: t.MinAmount.String(),
+		}
+		if t.FinSum != nil {
+			finSum := t.FinSum.String()
+			tier.FinSum = &finSum
+		}
+		res.Tiers = append(res.Tiers, tier)
+	}
+	return res
+}
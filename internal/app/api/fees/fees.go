@@ -0,0 +1,275 @@
+// Package fees computes transfer fees by the tiered schedules stored in the fees table.
+//
+// A schedule is a set of tiers with the same effective_from, the latest schedule that is already
+// effective applies. A tier charges a percent of the amount, but not less than its minimum.
+package fees
+
+import (
+	"context"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-pg/pg"
+	"github.com/go-pg/pg/orm"
+	"github.com/pkg/errors"
+
+	"github.com/insolar/observer/internal/dbconn"
+	"github.com/insolar/observer/internal/models"
+)
+
+// Tier charges Percent of amounts in [StartSum, FinSum), nil FinSum means no upper bound.
+type Tier struct {
+	StartSum  *big.Int
+	FinSum    *big.Int
+	Percent   *big.Rat
+	MinAmount *big.Int
+}
+
+// Schedule is a set of non-overlapping tiers sorted by StartSum.
+type Schedule struct {
+	EffectiveFrom time.Time
+	Tiers         []Tier
+}
+
+// Percents are stored as decimals, so they must be exact with this precision.
+const maxPercentDigits = 18
+
+var hundred = big.NewRat(100, 1)
+
+// Fee is the percent of amount rounded up, but not less than the tier minimum.
+// It returns false if no tier matches the amount.
+func (s Schedule) Fee(amount *big.Int) (*big.Int, bool) {
+	for _, t := range s.Tiers {
+		if amount.Cmp(t.StartSum) < 0 || (t.FinSum != nil && amount.Cmp(t.FinSum) >= 0) {
+			continue
+		}
+		fee := new(big.Rat).Mul(new(big.Rat).SetInt(amount), t.Percent)
+		fee.Quo(fee, hundred)
+		res := new(big.Int).Quo(fee.Num(), fee.Denom())
+		if !fee.IsInt() {
+			res.Add(res, big.NewInt(1))
+		}
+		if res.Cmp(t.MinAmount) < 0 {
+			res.Set(t.MinAmount)
+		}
+		return res, true
+	}
+	return nil, false
+}
+
+// Validate sorts tiers and checks they don't overlap.
+func (s *Schedule) Validate() error {
+	if len(s.Tiers) == 0 {
+		return errors.New("schedule has no tiers")
+	}
+	for i, t := range s.Tiers {
+		switch {
+		case t.StartSum == nil || t.Percent == nil || t.MinAmount == nil:
+			return errors.Errorf("tier %d is incomplete", i)
+		case t.StartSum.Sign() < 0 || t.MinAmount.Sign() < 0:
+			return errors.Errorf("tier %d has negative sums", i)
+		case t.FinSum != nil && t.FinSum.Cmp(t.StartSum) <= 0:
+			return errors.Errorf("tier %d ends before it starts", i)
+		case t.Percent.Sign() < 0 || t.Percent.Cmp(hundred) > 0:
+			return errors.Errorf("tier %d percent should be from 0 to 100", i)
+		case !ratEqual(t.Percent.FloatString(maxPercentDigits), t.Percent):
+			return errors.Errorf("tier %d percent should have at most %d digits after the point", i, maxPercentDigits)
+		}
+	}
+	sort.Slice(s.Tiers, func(i, j int) bool {
+		return s.Tiers[i].StartSum.Cmp(s.Tiers[j].StartSum) < 0
+	})
+	for i := 1; i < len(s.Tiers); i++ {
+		prev := s.Tiers[i-1]
+		if prev.FinSum == nil || prev.FinSum.Cmp(s.Tiers[i].StartSum) > 0 {
+			return errors.Errorf("tiers starting at %s and %s overlap", prev.StartSum, s.Tiers[i].StartSum)
+		}
+	}
+	return nil
+}
+
+// Calculator caches schedules from the database and falls back to the constant fee without them.
+type Calculator struct {
+	db       *dbconn.Pool
+	fallback *big.Int
+	ttl      time.Duration
+
+	mu        sync.Mutex
+	schedules []Schedule
+	loaded    time.Time
+}
+
+// NewCalculator creates the calculator, schedules are reloaded when they are older than ttl.
+func NewCalculator(db *dbconn.Pool, fallback *big.Int, ttl time.Duration) *Calculator {
+	return &Calculator{db: db, fallback: fallback, ttl: ttl}
+}
+
+// Fee computes the fee of amount by the schedule effective at the time.
+func (c *Calculator) Fee(ctx context.Context, amount *big.Int, at time.Time) (*big.Int, error) {
+	schedules, err := c.Schedules(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := len(schedules) - 1; i >= 0; i-- {
+		if schedules[i].EffectiveFrom.After(at) {
+			continue
+		}
+		if fee, ok := schedules[i].Fee(amount); ok {
+			return fee, nil
+		}
+		break
+	}
+	return new(big.Int).Set(c.fallback), nil
+}
+
+// Schedules returns cached schedules sorted by EffectiveFrom.
+func (c *Calculator) Schedules(ctx context.Context) ([]Schedule, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.loaded.IsZero() && time.Since(c.loaded) < c.ttl {
+		return c.schedules, nil
+	}
+	return c.load(ctx, c.db.Read())
+}
+
+// load must be called under the lock.
+func (c *Calculator) load(ctx context.Context, db orm.DB) ([]Schedule, error) {
+	var rows []models.Fee
+	if err := db.ModelContext(ctx, &rows).Order("effective_from", "id").Select(); err != nil {
+		return nil, errors.Wrap(err, "failed to load fee schedules")
+	}
+	schedules, err := fromRows(rows)
+	if err != nil {
+		return nil, err
+	}
+	c.schedules, c.loaded = schedules, time.Now()
+	return schedules, nil
+}
+
+// Save replaces the schedule with the same EffectiveFrom.
+func (c *Calculator) Save(ctx context.Context, s Schedule) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	err := c.db.Primary().RunInTransaction(func(tx *pg.Tx) error {
+		if _, err := tx.ModelContext(ctx, (*models.Fee)(nil)).Where("effective_from = ?", s.EffectiveFrom).Delete(); err != nil {
+			return err
+		}
+		rows := toRows(s)
+		_, err := tx.ModelContext(ctx, &rows).Insert()
+		return err
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to save fee schedule")
+	}
+	c.reload(ctx)
+	return nil
+}
+
+// Delete removes the schedule, it returns false if there is no such schedule.
+func (c *Calculator) Delete(ctx context.Context, effectiveFrom time.Time) (bool, error) {
+	res, err := c.db.Primary().ModelContext(ctx, (*models.Fee)(nil)).Where("effective_from = ?", effectiveFrom).Delete()
+	if err != nil {
+		return false, errors.Wrap(err, "failed to delete fee schedule")
+	}
+	c.reload(ctx)
+	return res.RowsAffected() > 0, nil
+}
+
+// reload loads changed schedules from the primary, replicas may not have the change yet.
+// Schedules are loaded again on the next use if it fails.
+func (c *Calculator) reload(ctx context.Context) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, err := c.load(ctx, c.db.Primary()); err != nil {
+		c.loaded = time.Time{}
+	}
+}
+
+func fromRows(rows []models.Fee) ([]Schedule, error) {
+	var schedules []Schedule
+	for _, row := range rows {
+		// Legacy rows may have NULL columns, a row without percent and minimum doesn't define a fee.
+		if row.Percent == "" && row.MinAmount == "" {
+			continue
+		}
+		tier, err := parseTier(row)
+		if err != nil {
+			return nil, errors.Wrapf(err, "fee tier %d", row.ID)
+		}
+		if n := len(schedules); n == 0 || !schedules[n-1].EffectiveFrom.Equal(row.EffectiveFrom) {
+			schedules = append(schedules, Schedule{EffectiveFrom: row.EffectiveFrom})
+		}
+		schedules[len(schedules)-1].Tiers = append(schedules[len(schedules)-1].Tiers, tier)
+	}
+	for i := range schedules {
+		if err := schedules[i].Validate(); err != nil {
+			return nil, errors.Wrapf(err, "fee schedule from %s", schedules[i].EffectiveFrom)
+		}
+	}
+	return schedules, nil
+}
+
+// parseTier treats NULL start sum, percent and minimum of legacy rows as zero.
+func parseTier(row models.Fee) (Tier, error) {
+	var (
+		t  Tier
+		ok bool
+	)
+	for _, v := range []*string{&row.StartSum, &row.Percent, &row.MinAmount} {
+		if *v == "" {
+			*v = "0"
+		}
+	}
+	if t.StartSum, ok = new(big.Int).SetString(row.StartSum, 10); !ok {
+		return t, errors.Errorf("wrong start sum %q", row.StartSum)
+	}
+	if row.FinSum != "" {
+		if t.FinSum, ok = new(big.Int).SetString(row.FinSum, 10); !ok {
+			return t, errors.Errorf("wrong fin sum %q", row.FinSum)
+		}
+	}
+	if t.Percent, ok = new(big.Rat).SetString(row.Percent); !ok {
+		return t, errors.Errorf("wrong percent %q", row.Percent)
+	}
+	if t.MinAmount, ok = new(big.Int).SetString(row.MinAmount, 10); !ok {
+		return t, errors.Errorf("wrong min amount %q", row.MinAmount)
+	}
+	return t, nil
+}
+
+func toRows(s Schedule) []models.Fee {
+	rows := make([]models.Fee, 0, len(s.Tiers))
+	for _, t := range s.Tiers {
+		row := models.Fee{
+			StartSum:      t.StartSum.String(),
+			Percent:       t.PercentString(),
+			MinAmount:     t.MinAmount.String(),
+			EffectiveFrom: s.EffectiveFrom,
+		}
+		if t.FinSum != nil {
+			row.FinSum = t.FinSum.String()
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// PercentString formats the percent as a decimal with the fewest digits after the point,
+// percents of validated tiers are exact.
+func (t Tier) PercentString() string {
+	for prec := 0; prec < maxPercentDigits; prec++ {
+		if s := t.Percent.FloatString(prec); ratEqual(s, t.Percent) {
+			return s
+		}
+	}
+	return t.Percent.FloatString(maxPercentDigits)
+}
+
+func ratEqual(s string, r *big.Rat) bool {
+	v, ok := new(big.Rat).SetString(s)
+	return ok && v.Cmp(r) == 0
+}
@@ -0,0 +1,102 @@
+package fees
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/observer/internal/models"
+)
+
+func tier(start, fin int64, percent string, min int64) Tier {
+	t := Tier{StartSum: big.NewInt(start), MinAmount: big.NewInt(min)}
+	if fin > 0 {
+		t.FinSum = big.NewInt(fin)
+	}
+	t.Percent, _ = new(big.Rat).SetString(percent)
+	return t
+}
+
+func TestSchedule_Fee(t *testing.T) {
+	s := Schedule{Tiers: []Tier{
+		tier(1000, 0, "0.5", 20),
+		tier(10, 1000, "1.5", 5),
+	}}
+	require.NoError(t, s.Validate())
+
+	for amount, expected := range map[int64]string{
+		10:    "5",
+		999:   "15",
+		1000:  "20",
+		4001:  "21",
+		10000: "50",
+	} {
+		fee, ok := s.Fee(big.NewInt(amount))
+		require.True(t, ok, amount)
+		require.Equal(t, expected, fee.String(), amount)
+	}
+
+	_, ok := s.Fee(big.NewInt(9))
+	require.False(t, ok)
+}
+
+func TestSchedule_Validate(t *testing.T) {
+	tests := map[string][]Tier{
+		"empty":        nil,
+		"overlap":      {tier(0, 100, "1", 0), tier(50, 0, "1", 0)},
+		"unbounded":    {tier(0, 0, "1", 0), tier(50, 100, "1", 0)},
+		"reversed":     {tier(100, 50, "1", 0)},
+		"percent":      {tier(0, 0, "101", 0)},
+		"inexact":      {tier(0, 0, "1/3", 0)},
+		"negative":     {tier(-1, 0, "1", 0)},
+		"min negative": {tier(0, 0, "1", -1)},
+	}
+	for name, tiers := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := Schedule{Tiers: tiers}
+			require.Error(t, s.Validate())
+		})
+	}
+}
+
+func TestRows(t *testing.T) {
+	first := time.Unix(1600000000, 0)
+	second := first.Add(time.Hour)
+	s := Schedule{EffectiveFrom: second, Tiers: []Tier{tier(0, 100, "0.125", 1), tier(100, 0, "1/4", 2)}}
+
+	rows := append([]models.Fee{{StartSum: "0", Percent: "1", MinAmount: "0", EffectiveFrom: first}}, toRows(s)...)
+	require.Equal(t, "0.125", rows[1].Percent)
+	require.Equal(t, "", rows[2].FinSum)
+
+	schedules, err := fromRows(rows)
+	require.NoError(t, err)
+	require.Len(t, schedules, 2)
+	require.True(t, first.Equal(schedules[0].EffectiveFrom))
+	require.Len(t, schedules[1].Tiers, 2)
+	require.Nil(t, schedules[1].Tiers[1].FinSum)
+
+	rows[1].Percent = "ten"
+	_, err = fromRows(rows)
+	require.Error(t, err)
+}
+
+func TestRows_Legacy(t *testing.T) {
+	rows := []models.Fee{
+		{FinSum: "100", Percent: "1"},
+		{StartSum: "100"},
+		{StartSum: "100", MinAmount: "5"},
+	}
+	schedules, err := fromRows(rows)
+	require.NoError(t, err)
+	require.Len(t, schedules, 1)
+	require.Len(t, schedules[0].Tiers, 2)
+
+	fee, ok := schedules[0].Fee(big.NewInt(50))
+	require.True(t, ok)
+	require.Equal(t, "1", fee.String())
+	fee, ok = schedules[0].Fee(big.NewInt(1000))
+	require.True(t, ok)
+	require.Equal(t, "5", fee.String())
+}
@@ -0,0 +1,88 @@
+// +build !node
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/observer/internal/models"
+)
+
+func feeAdmin() *httptest.Server {
+	e := echo.New()
+	noAuth := func(next echo.HandlerFunc) echo.HandlerFunc { return next }
+	RegisterFeeHandlers(e, NewServer(db, inslogger.FromContext(context.Background()), pStorage).(*ObserverServerExtended), noAuth)
+	return httptest.NewServer(e)
+}
+
+func getFee(t *testing.T, amount string) string {
+	resp, err := http.Get("http://" + apihost + "/api/fee/" + amount)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	bodyBytes, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	received := ResponsesFeeYaml{}
+	require.NoError(t, json.Unmarshal(bodyBytes, &received))
+	return received.Fee
+}
+
+func TestFeeSchedules(t *testing.T) {
+	defer func() {
+		_, err := db.Model(&models.Fee{}).Exec("TRUNCATE TABLE ?TableName")
+		require.NoError(t, err)
+	}()
+	admin := feeAdmin()
+	defer admin.Close()
+
+	schedule := `{"effectiveFrom": 1600000000, "tiers": [
+		{"startSum": "100000", "percent": "0.5", "minAmount": "1000"},
+		{"startSum": "0", "finSum": "100000", "percent": "1", "minAmount": "100"}
+	]}`
+	resp, err := http.Post(admin.URL+"/admin/fees", "application/json", strings.NewReader(schedule))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	require.Equal(t, "100", getFee(t, "123"))
+	require.Equal(t, "999", getFee(t, "99900"))
+	require.Equal(t, "5000", getFee(t, "1000000"))
+
+	resp, err = http.Get(admin.URL + "/admin/fees")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	bodyBytes, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	var received []FeeSchedule
+	require.NoError(t, json.Unmarshal(bodyBytes, &received))
+	require.Len(t, received, 1)
+	require.Equal(t, int64(1600000000), received[0].EffectiveFrom)
+	require.Len(t, received[0].Tiers, 2)
+	require.Equal(t, "0", received[0].Tiers[0].StartSum)
+	require.Nil(t, received[0].Tiers[1].FinSum)
+
+	overlapping := `{"effectiveFrom": 1600000000, "tiers": [
+		{"startSum": "0", "percent": "1", "minAmount": "100"},
+		{"startSum": "100", "percent": "1", "minAmount": "100"}
+	]}`
+	resp, err = http.Post(admin.URL+"/admin/fees", "application/json", strings.NewReader(overlapping))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	req, err := http.NewRequest(http.MethodDelete, fmt.Sprintf("%s/admin/fees/%d", admin.URL, 1600000000), nil)
+	require.NoError(t, err)
+	resp, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	require.Equal(t, testFee.String(), getFee(t, "1000000"))
+}
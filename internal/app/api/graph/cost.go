@@ -0,0 +1,130 @@
+package graph
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+const (
+	defaultFirst = 20
+	maxFirst     = 100
+	// Deposits aren't paged, members rarely have more of them.
+	depositsCost = 10
+)
+
+// queryCost estimates how many objects the operation resolves and how deeply its fields are nested.
+// Every field costs 1, connections multiply the cost of their nodes by page size.
+func queryCost(doc *ast.Document, operationName string, vars map[string]interface{}) (int, int) {
+	w := costWalker{
+		fragments: make(map[string]*ast.FragmentDefinition),
+		visiting:  make(map[string]bool),
+		vars:      make(map[string]interface{}, len(vars)),
+	}
+	for name, v := range vars {
+		w.vars[name] = v
+	}
+	var operation *ast.OperationDefinition
+	for _, def := range doc.Definitions {
+		switch def := def.(type) {
+		case *ast.FragmentDefinition:
+			w.fragments[def.Name.Value] = def
+		case *ast.OperationDefinition:
+			if operation == nil || (def.Name != nil && def.Name.Value == operationName) {
+				operation = def
+			}
+		}
+	}
+	if operation == nil {
+		return 0, 0
+	}
+	// Defaults of omitted variables change page size as well.
+	for _, def := range operation.VariableDefinitions {
+		name := def.Variable.Name.Value
+		if v, ok := def.DefaultValue.(*ast.IntValue); ok && w.vars[name] == nil {
+			n, _ := strconv.Atoi(v.Value)
+			w.vars[name] = n
+		}
+	}
+	return w.selectionSet(operation.SelectionSet, 0)
+}
+
+type costWalker struct {
+	fragments map[string]*ast.FragmentDefinition
+	// Fragments on the current path, cycles are reported by validation.
+	visiting map[string]bool
+	vars     map[string]interface{}
+}
+
+func (w *costWalker) selectionSet(set *ast.SelectionSet, depth int) (int, int) {
+	if set == nil {
+		return 0, depth
+	}
+	cost, maxDepth := 0, depth
+	for _, sel := range set.Selections {
+		var c, d int
+		switch sel := sel.(type) {
+		case *ast.Field:
+			if strings.HasPrefix(sel.Name.Value, "__") {
+				continue
+			}
+			c, d = w.selectionSet(sel.SelectionSet, depth+1)
+			c = 1 + w.multiplier(sel)*c
+		case *ast.InlineFragment:
+			c, d = w.selectionSet(sel.SelectionSet, depth)
+		case *ast.FragmentSpread:
+			name := sel.Name.Value
+			frag, ok := w.fragments[name]
+			if !ok || w.visiting[name] {
+				continue
+			}
+			w.visiting[name] = true
+			c, d = w.selectionSet(frag.SelectionSet, depth)
+			delete(w.visiting, name)
+		}
+		cost += c
+		if d > maxDepth {
+			maxDepth = d
+		}
+	}
+	return cost, maxDepth
+}
+
+func (w *costWalker) multiplier(field *ast.Field) int {
+	switch field.Name.Value {
+	case "transactions":
+		return w.first(field)
+	case "deposits":
+		return depositsCost
+	}
+	return 1
+}
+
+// first is the page size, invalid values are rejected by resolver.
+func (w *costWalker) first(field *ast.Field) int {
+	for _, arg := range field.Arguments {
+		if arg.Name.Value != "first" {
+			continue
+		}
+		var n int
+		switch v := arg.Value.(type) {
+		case *ast.IntValue:
+			n, _ = strconv.Atoi(v.Value)
+		case *ast.Variable:
+			switch value := w.vars[v.Name.Value].(type) {
+			case float64:
+				n = int(value)
+			case int:
+				n = value
+			default:
+				return defaultFirst
+			}
+		}
+		if n < 1 || n > maxFirst {
+			return maxFirst
+		}
+		return n
+	}
+	return defaultFirst
+}
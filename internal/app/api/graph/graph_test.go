@@ -0,0 +1,173 @@
+package graph
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/observer/configuration"
+	"github.com/insolar/observer/internal/models"
+)
+
+func TestQueryCost(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		vars  map[string]interface{}
+		cost  int
+		depth int
+	}{
+		{
+			name:  "fields",
+			query: `{ pulse(number: 1) { number timestamp } }`,
+			cost:  3,
+			depth: 2,
+		},
+		{
+			name:  "connection",
+			query: `{ member(reference: "r") { transactions(first: 10) { edges { node { txID } } } } }`,
+			cost:  1 + 1 + 10*(1+1+1),
+			depth: 5,
+		},
+		{
+			name:  "default page size",
+			query: `{ member(reference: "r") { transactions { totalCount } } }`,
+			cost:  1 + 1 + defaultFirst,
+			depth: 3,
+		},
+		{
+			name:  "variable",
+			query: `query($n: Int = 5) { member(reference: "r") { transactions(first: $n) { totalCount } } }`,
+			vars:  map[string]interface{}{"n": float64(50)},
+			cost:  1 + 1 + 50,
+			depth: 3,
+		},
+		{
+			name:  "variable default",
+			query: `query($n: Int = 5) { member(reference: "r") { transactions(first: $n) { totalCount } } }`,
+			cost:  1 + 1 + 5,
+			depth: 3,
+		},
+		{
+			name: "fragments",
+			query: `{ member(reference: "r") { ...m deposits { ... on Deposit { amount } } } }
+				fragment m on Member { balance }`,
+			cost:  1 + 1 + 1 + depositsCost,
+			depth: 3,
+		},
+		{
+			name:  "introspection",
+			query: `{ __schema { types { name fields { name type { ofType { ofType { name } } } } } } }`,
+			cost:  0,
+			depth: 0,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			doc, err := parser.Parse(parser.ParseParams{Source: test.query})
+			require.NoError(t, err)
+			cost, depth := queryCost(doc, "", test.vars)
+			require.Equal(t, test.cost, cost)
+			require.Equal(t, test.depth, depth)
+		})
+	}
+}
+
+func TestBatch(t *testing.T) {
+	var calls [][][]byte
+	b := newBatch(func(ctx context.Context, keys [][]byte) (map[string]interface{}, error) {
+		calls = append(calls, keys)
+		res := map[string]interface{}{}
+		for _, key := range keys {
+			if string(key) != "missing" {
+				res[string(key)] = "value " + string(key)
+			}
+		}
+		return res, nil
+	})
+	ctx := context.Background()
+
+	first, second, missing := b.get(ctx, []byte("a")), b.get(ctx, []byte("b")), b.get(ctx, []byte("missing"))
+	v, err := first()
+	require.NoError(t, err)
+	require.Equal(t, "value a", v)
+	v, err = second()
+	require.NoError(t, err)
+	require.Equal(t, "value b", v)
+	v, err = missing()
+	require.NoError(t, err)
+	require.Nil(t, v)
+	require.Len(t, calls, 1)
+	require.Len(t, calls[0], 3)
+
+	// Loaded keys are cached.
+	v, err = b.get(ctx, []byte("missing"))()
+	require.NoError(t, err)
+	require.Nil(t, v)
+	require.Len(t, calls, 1)
+}
+
+func TestQuery_Rejected(t *testing.T) {
+	config := configuration.API{}.Default()
+	config.GraphQL = configuration.GraphQL{MaxCost: 100, MaxDepth: 4}
+	s := NewServer(nil, inslogger.FromContext(context.Background()), *config)
+	e := echo.New()
+	RegisterHandler(e, s)
+
+	tests := []struct {
+		name  string
+		query string
+		error string
+	}{
+		{name: "syntax", query: `{ member(`, error: "Syntax Error"},
+		{name: "unknown field", query: `{ members { balance } }`, error: "Cannot query field"},
+		{name: "cost", query: `{ member(reference: "r") { transactions(first: 100) { totalCount } } }`, error: "Query cost 102 exceeds the limit of 100."},
+		{name: "depth", query: `{ member(reference: "r") { transactions(first: 1) { edges { node { txID } } } } }`, error: "Query depth 5 exceeds the limit of 4."},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, Path+"?query="+url.QueryEscape(test.query), nil)
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+			require.Equal(t, http.StatusBadRequest, rec.Code)
+			require.Contains(t, rec.Body.String(), test.error)
+		})
+	}
+
+	req := httptest.NewRequest(http.MethodPost, Path, strings.NewReader(`{"query": ""}`))
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	require.Contains(t, rec.Body.String(), "Query is empty.")
+}
+
+func TestPulse_Timestamp(t *testing.T) {
+	s := NewServer(nil, inslogger.FromContext(context.Background()), *configuration.API{}.Default())
+	l := &loaders{pulses: newBatch(func(ctx context.Context, keys [][]byte) (map[string]interface{}, error) {
+		res := make(map[string]interface{}, len(keys))
+		for _, key := range keys {
+			res[string(key)] = &models.Pulse{Pulse: pulseFromKey(key), PulseDate: 1600000000 * time.Second.Nanoseconds()}
+		}
+		return res, nil
+	})}
+
+	res := graphql.Do(graphql.Params{
+		Schema:        s.schema,
+		RequestString: `{ pulse(number: 65537) { number timestamp } }`,
+		Context:       context.WithValue(context.Background(), loadersKey{}, l),
+	})
+	require.Empty(t, res.Errors)
+	require.Equal(t, map[string]interface{}{
+		"pulse": map[string]interface{}{"number": 65537, "timestamp": 1600000000},
+	}, res.Data)
+}
@@ -0,0 +1,106 @@
+// Package graph serves members, deposits, transactions, pulses and stats over GraphQL.
+package graph
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/insolar/insolar/insolar"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/insolar/observer/configuration"
+	"github.com/insolar/observer/internal/app/api"
+	"github.com/insolar/observer/internal/dbconn"
+)
+
+// Path accepts queries with GET and POST, they never write so POST is allowed in readonly mode.
+const Path = "/graphql"
+
+type Server struct {
+	db      *dbconn.Pool
+	log     insolar.Logger
+	cfg     configuration.GraphQL
+	cursors api.CursorCodec
+	schema  graphql.Schema
+}
+
+// Request is a query in the POST body or in the query, variables and operationName GET parameters.
+type Request struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+func NewServer(db *dbconn.Pool, log insolar.Logger, config configuration.APIConfig) *Server {
+	cursors, err := api.NewCursorCodec(config.GetCursorSecret())
+	if err != nil {
+		log.Fatal(err)
+	}
+	s := &Server{db: db, log: log, cfg: config.GetGraphQL(), cursors: cursors}
+	s.schema, err = s.newSchema()
+	if err != nil {
+		log.Fatal(errors.Wrap(err, "failed to build graphql schema"))
+	}
+	return s
+}
+
+func RegisterHandler(router runtime.EchoRouter, s *Server) {
+	router.GET(Path, s.Query)
+	router.POST(Path, s.Query)
+}
+
+// Query responds with 400 if the query is invalid or too expensive, errors of resolvers are in the result.
+func (s *Server) Query(ctx echo.Context) error {
+	req := Request{}
+	if ctx.Request().Method == http.MethodGet {
+		req.Query = ctx.QueryParam("query")
+		req.OperationName = ctx.QueryParam("operationName")
+		if vars := ctx.QueryParam("variables"); vars != "" {
+			if err := json.Unmarshal([]byte(vars), &req.Variables); err != nil {
+				return ctx.JSON(http.StatusBadRequest, failed("Query parameter 'variables' should be a JSON object."))
+			}
+		}
+	} else if err := json.NewDecoder(ctx.Request().Body).Decode(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, failed("Request body should be a JSON object with query."))
+	}
+	if req.Query == "" {
+		return ctx.JSON(http.StatusBadRequest, failed("Query is empty."))
+	}
+
+	doc, err := parser.Parse(parser.ParseParams{Source: req.Query})
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, &graphql.Result{Errors: gqlerrors.FormatErrors(err)})
+	}
+	validation := graphql.ValidateDocument(&s.schema, doc, nil)
+	if !validation.IsValid {
+		return ctx.JSON(http.StatusBadRequest, &graphql.Result{Errors: validation.Errors})
+	}
+	cost, depth := queryCost(doc, req.OperationName, req.Variables)
+	if depth > s.cfg.MaxDepth {
+		return ctx.JSON(http.StatusBadRequest, failed(fmt.Sprintf("Query depth %d exceeds the limit of %d.", depth, s.cfg.MaxDepth)))
+	}
+	if cost > s.cfg.MaxCost {
+		return ctx.JSON(http.StatusBadRequest, failed(fmt.Sprintf("Query cost %d exceeds the limit of %d.", cost, s.cfg.MaxCost)))
+	}
+
+	res := graphql.Execute(graphql.ExecuteParams{
+		Schema:        s.schema,
+		AST:           doc,
+		OperationName: req.OperationName,
+		Args:          req.Variables,
+		Context:       context.WithValue(ctx.Request().Context(), loadersKey{}, newLoaders(s.db.Read())),
+	})
+	res.Extensions = map[string]interface{}{"cost": cost}
+	return ctx.JSON(http.StatusOK, res)
+}
+
+func failed(message string) *graphql.Result {
+	return &graphql.Result{Errors: []gqlerrors.FormattedError{gqlerrors.NewFormattedError(message)}}
+}
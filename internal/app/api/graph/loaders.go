@@ -0,0 +1,137 @@
+package graph
+
+import (
+	"context"
+	"sync"
+
+	"github.com/go-pg/pg"
+	"github.com/go-pg/pg/orm"
+	"github.com/pkg/errors"
+
+	"github.com/insolar/observer/internal/models"
+)
+
+// batch collects keys requested by sibling fields and loads them with one query when the first of them is resolved.
+// Resolvers return thunks, graphql executes them breadth first, so a whole level of a query is loaded at once.
+type batch struct {
+	load func(ctx context.Context, keys [][]byte) (map[string]interface{}, error)
+
+	mu      sync.Mutex
+	pending map[string][]byte
+	// Missing keys are stored with nil values to not query them again.
+	results map[string]interface{}
+}
+
+func newBatch(load func(ctx context.Context, keys [][]byte) (map[string]interface{}, error)) *batch {
+	return &batch{load: load, pending: make(map[string][]byte), results: make(map[string]interface{})}
+}
+
+func (b *batch) get(ctx context.Context, key []byte) func() (interface{}, error) {
+	k := string(key)
+	b.mu.Lock()
+	if _, ok := b.results[k]; !ok {
+		b.pending[k] = key
+	}
+	b.mu.Unlock()
+
+	return func() (interface{}, error) {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		if _, ok := b.results[k]; !ok && len(b.pending) > 0 {
+			keys := make([][]byte, 0, len(b.pending))
+			for _, key := range b.pending {
+				keys = append(keys, key)
+			}
+			loaded, err := b.load(ctx, keys)
+			if err != nil {
+				return nil, err
+			}
+			for pk := range b.pending {
+				b.results[pk] = loaded[pk]
+			}
+			b.pending = make(map[string][]byte)
+		}
+		return b.results[k], nil
+	}
+}
+
+// loaders are made for every request, so results are never stale.
+type loaders struct {
+	members            *batch
+	deposits           *batch
+	augmentedAddresses *batch
+	pulses             *batch
+}
+
+func newLoaders(db orm.DB) *loaders {
+	return &loaders{
+		members: newBatch(func(ctx context.Context, keys [][]byte) (map[string]interface{}, error) {
+			var members []models.Member
+			err := db.ModelContext(ctx, &members).Where("member_ref in (?)", pg.In(keys)).Select()
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to select members")
+			}
+			res := make(map[string]interface{}, len(members))
+			for i := range members {
+				res[string(members[i].Reference)] = &members[i]
+			}
+			return res, nil
+		}),
+		deposits: newBatch(func(ctx context.Context, keys [][]byte) (map[string]interface{}, error) {
+			var deposits []models.Deposit
+			err := db.ModelContext(ctx, &deposits).
+				Where("member_ref in (?)", pg.In(keys)).
+				Order("deposit_number ASC").
+				Select()
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to select deposits")
+			}
+			byMember := make(map[string][]models.Deposit)
+			for _, d := range deposits {
+				byMember[string(d.MemberReference)] = append(byMember[string(d.MemberReference)], d)
+			}
+			res := make(map[string]interface{}, len(keys))
+			for _, key := range keys {
+				// Empty list rather than null for members without deposits.
+				res[string(key)] = append([]models.Deposit{}, byMember[string(key)]...)
+			}
+			return res, nil
+		}),
+		augmentedAddresses: newBatch(func(ctx context.Context, keys [][]byte) (map[string]interface{}, error) {
+			var addresses []models.AugmentedAddress
+			err := db.ModelContext(ctx, &addresses).Where("member_ref in (?)", pg.In(keys)).Select()
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to select augmented addresses")
+			}
+			res := make(map[string]interface{}, len(addresses))
+			for _, a := range addresses {
+				res[string(a.Reference)] = a.Address
+			}
+			return res, nil
+		}),
+		pulses: newBatch(func(ctx context.Context, keys [][]byte) (map[string]interface{}, error) {
+			numbers := make([]int64, 0, len(keys))
+			for _, key := range keys {
+				numbers = append(numbers, int64(pulseFromKey(key)))
+			}
+			var pulses []models.Pulse
+			err := db.ModelContext(ctx, &pulses).Where("pulse in (?)", pg.In(numbers)).Select()
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to select pulses")
+			}
+			res := make(map[string]interface{}, len(pulses))
+			for i := range pulses {
+				res[string(pulseKey(pulses[i].Pulse))] = &pulses[i]
+			}
+			return res, nil
+		}),
+	}
+}
+
+func pulseKey(pn uint32) []byte {
+	return []byte{byte(pn >> 24), byte(pn >> 16), byte(pn >> 8), byte(pn)}
+}
+
+func pulseFromKey(key []byte) uint32 {
+	return uint32(key[0])<<24 | uint32(key[1])<<16 | uint32(key[2])<<8 | uint32(key[3])
+}
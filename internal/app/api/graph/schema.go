@@ -0,0 +1,521 @@
+package graph
+
+import (
+	"context"
+	"encoding/base64"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/go-pg/pg"
+	"github.com/go-pg/pg/orm"
+	"github.com/graphql-go/graphql"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/logicrunner/builtin/foundation"
+	"github.com/pkg/errors"
+
+	"github.com/insolar/observer/component"
+	"github.com/insolar/observer/internal/app/api"
+	"github.com/insolar/observer/internal/models"
+)
+
+type loadersKey struct{}
+
+func loadersFrom(ctx context.Context) *loaders {
+	return ctx.Value(loadersKey{}).(*loaders)
+}
+
+// connection is a page of member transactions, total count is queried only if asked for.
+type connection struct {
+	edges   []edge
+	hasNext bool
+	count   *orm.Query
+}
+
+type edge struct {
+	cursor string
+	node   models.Transaction
+}
+
+func reference(b []byte) interface{} {
+	if len(b) == 0 {
+		return nil
+	}
+	return insolar.NewReferenceFromBytes(b).String()
+}
+
+func nonNull(t graphql.Output) graphql.Output {
+	return graphql.NewNonNull(t)
+}
+
+func (s *Server) newSchema() (graphql.Schema, error) {
+	directionEnum := graphql.NewEnum(graphql.EnumConfig{
+		Name: "Direction",
+		Values: graphql.EnumValueConfigMap{
+			"INCOMING": {Value: "incoming"},
+			"OUTGOING": {Value: "outgoing"},
+			"ALL":      {Value: "all"},
+		},
+	})
+	typeEnum := graphql.NewEnum(graphql.EnumConfig{
+		Name: "TransactionType",
+		Values: graphql.EnumValueConfigMap{
+			"TRANSFER":  {Value: string(models.TTypeTransfer)},
+			"MIGRATION": {Value: string(models.TTypeMigration)},
+			"RELEASE":   {Value: string(models.TTypeRelease)},
+		},
+	})
+	statusEnum := graphql.NewEnum(graphql.EnumConfig{
+		Name: "TransactionStatus",
+		Values: graphql.EnumValueConfigMap{
+			"REGISTERED": {Value: string(models.TStatusRegistered)},
+			"SENT":       {Value: string(models.TStatusSent)},
+			"RECEIVED":   {Value: string(models.TStatusReceived)},
+			"FAILED":     {Value: string(models.TStatusFailed)},
+		},
+	})
+
+	pulseType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Pulse",
+		Fields: graphql.Fields{
+			"number": &graphql.Field{Type: nonNull(graphql.Int), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return int(p.Source.(*models.Pulse).Pulse), nil
+			}},
+			"timestamp": &graphql.Field{Type: nonNull(graphql.Int), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(*models.Pulse).PulseDate / time.Second.Nanoseconds(), nil
+			}},
+			"nodes": &graphql.Field{Type: nonNull(graphql.Int), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return int(p.Source.(*models.Pulse).Nodes), nil
+			}},
+			"entropy": &graphql.Field{Type: nonNull(graphql.String), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return base64.StdEncoding.EncodeToString(p.Source.(*models.Pulse).Entropy), nil
+			}},
+		},
+	})
+
+	depositType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Deposit",
+		Fields: graphql.Fields{
+			"reference": &graphql.Field{Type: graphql.String, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return reference(p.Source.(models.Deposit).Reference), nil
+			}},
+			"memberReference": &graphql.Field{Type: graphql.String, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return reference(p.Source.(models.Deposit).MemberReference), nil
+			}},
+			"index": &graphql.Field{Type: graphql.Int, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				if n := p.Source.(models.Deposit).DepositNumber; n != nil {
+					return int(*n), nil
+				}
+				return nil, nil
+			}},
+			"ethTxHash": &graphql.Field{Type: nonNull(graphql.String), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(models.Deposit).EtheriumHash, nil
+			}},
+			"amount": &graphql.Field{Type: nonNull(graphql.String), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(models.Deposit).Amount, nil
+			}},
+			"balance": &graphql.Field{Type: nonNull(graphql.String), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(models.Deposit).Balance, nil
+			}},
+			"holdReleaseDate": &graphql.Field{Type: nonNull(graphql.Int), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(models.Deposit).HoldReleaseDate, nil
+			}},
+			"timestamp": &graphql.Field{Type: nonNull(graphql.Int), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(models.Deposit).Timestamp, nil
+			}},
+			"vesting": &graphql.Field{Type: nonNull(graphql.Int), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(models.Deposit).Vesting, nil
+			}},
+			"vestingStep": &graphql.Field{Type: nonNull(graphql.Int), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(models.Deposit).VestingStep, nil
+			}},
+			"status": &graphql.Field{Type: nonNull(graphql.String), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return string(p.Source.(models.Deposit).InnerStatus), nil
+			}},
+		},
+	})
+
+	var memberType *graphql.Object
+	transactionType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Transaction",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"txID": &graphql.Field{Type: nonNull(graphql.String), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return reference(p.Source.(models.Transaction).TransactionID), nil
+				}},
+				"index": &graphql.Field{Type: nonNull(graphql.String), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					tx := p.Source.(models.Transaction)
+					return tx.Index(models.TxIndexTypePulseRecord), nil
+				}},
+				"type": &graphql.Field{Type: nonNull(graphql.String), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return string(p.Source.(models.Transaction).Type), nil
+				}},
+				"status": &graphql.Field{Type: nonNull(graphql.String), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					tx := p.Source.(models.Transaction)
+					return string(tx.Status()), nil
+				}},
+				"amount": &graphql.Field{Type: nonNull(graphql.String), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(models.Transaction).Amount, nil
+				}},
+				"fee": &graphql.Field{Type: graphql.String, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					if fee := p.Source.(models.Transaction).Fee; fee != "" {
+						return fee, nil
+					}
+					return nil, nil
+				}},
+				"pulseNumber": &graphql.Field{Type: nonNull(graphql.Int), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					tx := p.Source.(models.Transaction)
+					return tx.PulseNumber(), nil
+				}},
+				"timestamp": &graphql.Field{Type: nonNull(graphql.Int), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					tx := p.Source.(models.Transaction)
+					load := loadersFrom(p.Context).pulses.get(p.Context, pulseKey(uint32(tx.PulseNumber())))
+					return func() (interface{}, error) {
+						loaded, err := load()
+						if err != nil {
+							return nil, err
+						}
+						if pulse, ok := loaded.(*models.Pulse); ok {
+							return pulse.PulseDate / time.Second.Nanoseconds(), nil
+						}
+						return tx.Timestamp(), nil
+					}, nil
+				}},
+				"fromMemberReference": &graphql.Field{Type: graphql.String, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return reference(p.Source.(models.Transaction).MemberFromReference), nil
+				}},
+				"toMemberReference": &graphql.Field{Type: graphql.String, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return reference(p.Source.(models.Transaction).MemberToReference), nil
+				}},
+				"fromDepositReference": &graphql.Field{Type: graphql.String, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return reference(p.Source.(models.Transaction).DepositFromReference), nil
+				}},
+				"toDepositReference": &graphql.Field{Type: graphql.String, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return reference(p.Source.(models.Transaction).DepositToReference), nil
+				}},
+				"fromMember": &graphql.Field{Type: memberType, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					ref := p.Source.(models.Transaction).MemberFromReference
+					if len(ref) == 0 {
+						return nil, nil
+					}
+					return loadersFrom(p.Context).members.get(p.Context, ref), nil
+				}},
+				"toMember": &graphql.Field{Type: memberType, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					ref := p.Source.(models.Transaction).MemberToReference
+					if len(ref) == 0 {
+						return nil, nil
+					}
+					return loadersFrom(p.Context).members.get(p.Context, ref), nil
+				}},
+				"pulse": &graphql.Field{Type: pulseType, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					tx := p.Source.(models.Transaction)
+					return loadersFrom(p.Context).pulses.get(p.Context, pulseKey(uint32(tx.PulseNumber()))), nil
+				}},
+			}
+		}),
+	})
+
+	edgeType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "TransactionEdge",
+		Fields: graphql.Fields{
+			"cursor": &graphql.Field{Type: nonNull(graphql.String), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(edge).cursor, nil
+			}},
+			"node": &graphql.Field{Type: nonNull(transactionType), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(edge).node, nil
+			}},
+		},
+	})
+	pageInfoType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "PageInfo",
+		Fields: graphql.Fields{
+			"hasNextPage": &graphql.Field{Type: nonNull(graphql.Boolean), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(*connection).hasNext, nil
+			}},
+			"endCursor": &graphql.Field{Type: graphql.String, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				c := p.Source.(*connection)
+				if len(c.edges) == 0 {
+					return nil, nil
+				}
+				return c.edges[len(c.edges)-1].cursor, nil
+			}},
+		},
+	})
+	connectionType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "TransactionConnection",
+		Fields: graphql.Fields{
+			"edges": &graphql.Field{Type: nonNull(graphql.NewList(nonNull(edgeType))), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(*connection).edges, nil
+			}},
+			"pageInfo": &graphql.Field{Type: nonNull(pageInfoType), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source, nil
+			}},
+			"totalCount": &graphql.Field{Type: nonNull(graphql.Int), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				count, err := p.Source.(*connection).count.Count()
+				if err != nil {
+					s.log.Error(errors.Wrap(err, "failed to count transactions"))
+					return nil, errInternal
+				}
+				return count, nil
+			}},
+		},
+	})
+
+	memberType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Member",
+		Fields: graphql.Fields{
+			"reference": &graphql.Field{Type: nonNull(graphql.String), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return reference(p.Source.(*models.Member).Reference), nil
+			}},
+			"walletReference": &graphql.Field{Type: graphql.String, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return reference(p.Source.(*models.Member).WalletReference), nil
+			}},
+			"accountReference": &graphql.Field{Type: graphql.String, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return reference(p.Source.(*models.Member).AccountReference), nil
+			}},
+			"migrationAddress": &graphql.Field{Type: graphql.String, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				if addr := p.Source.(*models.Member).MigrationAddress; addr != "" {
+					return addr, nil
+				}
+				return nil, nil
+			}},
+			"balance": &graphql.Field{Type: nonNull(graphql.String), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(*models.Member).Balance, nil
+			}},
+			"augmentedAddress": &graphql.Field{Type: graphql.String, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return loadersFrom(p.Context).augmentedAddresses.get(p.Context, p.Source.(*models.Member).Reference), nil
+			}},
+			"deposits": &graphql.Field{Type: nonNull(graphql.NewList(nonNull(depositType))), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return loadersFrom(p.Context).deposits.get(p.Context, p.Source.(*models.Member).Reference), nil
+			}},
+			"transactions": &graphql.Field{
+				Type: nonNull(connectionType),
+				Args: graphql.FieldConfigArgument{
+					"first":     &graphql.ArgumentConfig{Type: graphql.Int, DefaultValue: defaultFirst},
+					"after":     &graphql.ArgumentConfig{Type: graphql.String},
+					"direction": &graphql.ArgumentConfig{Type: directionEnum, DefaultValue: "all"},
+					"type":      &graphql.ArgumentConfig{Type: typeEnum},
+					"status":    &graphql.ArgumentConfig{Type: statusEnum, DefaultValue: string(models.TStatusRegistered)},
+					// Unix timestamps, resolved to pulses by their real dates.
+					"fromTimestamp": &graphql.ArgumentConfig{Type: graphql.Int},
+					"toTimestamp":   &graphql.ArgumentConfig{Type: graphql.Int},
+				},
+				Resolve: s.memberTransactions,
+			},
+		},
+	})
+
+	networkStatsType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "NetworkStats",
+		Fields: graphql.Fields{
+			"pulseNumber": &graphql.Field{Type: nonNull(graphql.Int), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(*models.NetworkStats).PulseNumber, nil
+			}},
+			"totalTransactions": &graphql.Field{Type: nonNull(graphql.Int), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(*models.NetworkStats).TotalTransactions, nil
+			}},
+			"monthTransactions": &graphql.Field{Type: nonNull(graphql.Int), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(*models.NetworkStats).MonthTransactions, nil
+			}},
+			"totalAccounts": &graphql.Field{Type: nonNull(graphql.Int), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(*models.NetworkStats).TotalAccounts, nil
+			}},
+			"nodes": &graphql.Field{Type: nonNull(graphql.Int), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(*models.NetworkStats).Nodes, nil
+			}},
+			"currentTPS": &graphql.Field{Type: nonNull(graphql.Int), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(*models.NetworkStats).CurrentTPS, nil
+			}},
+			"maxTPS": &graphql.Field{Type: nonNull(graphql.Int), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(*models.NetworkStats).MaxTPS, nil
+			}},
+			"created": &graphql.Field{Type: nonNull(graphql.Int), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(*models.NetworkStats).Created.Unix(), nil
+			}},
+		},
+	})
+	supplyStatsType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "SupplyStats",
+		Fields: graphql.Fields{
+			"total": &graphql.Field{Type: nonNull(graphql.String), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(*models.SupplyStats).TotalInXNS(), nil
+			}},
+			"created": &graphql.Field{Type: nonNull(graphql.Int), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(*models.SupplyStats).Created.Unix(), nil
+			}},
+		},
+	})
+
+	queryType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"member": &graphql.Field{
+				Type: memberType,
+				Args: graphql.FieldConfigArgument{"reference": &graphql.ArgumentConfig{Type: nonNull(graphql.String)}},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					ref, err := insolar.NewReferenceFromString(p.Args["reference"].(string))
+					if err != nil {
+						return nil, errors.New("Argument 'reference' should be a member reference.") // nolint
+					}
+					return loadersFrom(p.Context).members.get(p.Context, ref.Bytes()), nil
+				},
+			},
+			"memberByPublicKey": &graphql.Field{
+				Type:    memberType,
+				Args:    graphql.FieldConfigArgument{"publicKey": &graphql.ArgumentConfig{Type: nonNull(graphql.String)}},
+				Resolve: s.memberByPublicKey,
+			},
+			"transaction": &graphql.Field{
+				Type:    transactionType,
+				Args:    graphql.FieldConfigArgument{"txID": &graphql.ArgumentConfig{Type: nonNull(graphql.String)}},
+				Resolve: s.transaction,
+			},
+			"pulse": &graphql.Field{
+				Type: pulseType,
+				Args: graphql.FieldConfigArgument{"number": &graphql.ArgumentConfig{Type: nonNull(graphql.Int)}},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return loadersFrom(p.Context).pulses.get(p.Context, pulseKey(uint32(p.Args["number"].(int)))), nil
+				},
+			},
+			"networkStats": &graphql.Field{Type: networkStatsType, Resolve: s.networkStats},
+			"supplyStats":  &graphql.Field{Type: supplyStatsType, Resolve: s.supplyStats},
+		},
+	})
+
+	return graphql.NewSchema(graphql.SchemaConfig{Query: queryType})
+}
+
+var errInternal = errors.New("Internal error.") // nolint
+
+func (s *Server) memberTransactions(p graphql.ResolveParams) (interface{}, error) {
+	first := p.Args["first"].(int)
+	if first < 1 || first > maxFirst {
+		return nil, errors.Errorf("Argument 'first' should be in range [1, %d].", maxFirst)
+	}
+	ref := insolar.NewReferenceFromBytes(p.Source.(*models.Member).Reference)
+	direction := p.Args["direction"].(string)
+
+	filters := url.Values{}
+	filters.Set("member", ref.String())
+	filters.Set("direction", direction)
+	query := s.db.Read().ModelContext(p.Context, &models.Transaction{})
+	query, err := component.FilterByMemberReferenceAndDirection(query, ref, &direction)
+	if err != nil {
+		return nil, err
+	}
+	status := p.Args["status"].(string)
+	filters.Set("status", status)
+	if query, err = component.FilterByStatus(query, status); err != nil {
+		return nil, err
+	}
+	if t, ok := p.Args["type"].(string); ok {
+		filters.Set("type", t)
+		if query, err = component.FilterByType(query, t); err != nil {
+			return nil, err
+		}
+	}
+	var bounds [2]*int64
+	for i, name := range []string{"fromTimestamp", "toTimestamp"} {
+		if v, ok := p.Args[name].(int); ok {
+			timestamp := int64(v)
+			bounds[i] = &timestamp
+			filters.Set(name, strconv.Itoa(v))
+		}
+	}
+	if query, err = component.FilterByTimestamp(query, bounds[0], bounds[1], models.TxIndexTypePulseRecord); err != nil {
+		return nil, err
+	}
+	cursorQuery := "graphql/member.transactions?" + filters.Encode()
+	res := &connection{count: query.Copy()}
+
+	order := "reverse"
+	var pulse, record int64
+	positioned := false
+	if after, ok := p.Args["after"].(string); ok {
+		cur, err := s.cursors.Decode(after)
+		if err != nil || cur.Query != cursorQuery {
+			return nil, errors.New("Argument 'after' is invalid.") // nolint
+		}
+		pulse, record, positioned = cur.Pulse, cur.Record, true
+	}
+	query, err = component.OrderByIndex(query, &order, pulse, record, positioned, models.TxIndexTypePulseRecord)
+	if err != nil {
+		return nil, err
+	}
+	var txs []models.Transaction
+	if err := query.Limit(first + 1).Select(&txs); err != nil {
+		s.log.Error(errors.Wrap(err, "failed to select member transactions"))
+		return nil, errInternal
+	}
+	if len(txs) > first {
+		res.hasNext = true
+		txs = txs[:first]
+	}
+	res.edges = make([]edge, 0, len(txs))
+	for _, tx := range txs {
+		cursor := s.cursors.Encode(api.Cursor{Query: cursorQuery, Pulse: tx.PulseRecord[0], Record: tx.PulseRecord[1]})
+		res.edges = append(res.edges, edge{cursor: cursor, node: tx})
+	}
+	return res, nil
+}
+
+func (s *Server) memberByPublicKey(p graphql.ResolveParams) (interface{}, error) {
+	publicKey, err := foundation.ExtractCanonicalPublicKey(p.Args["publicKey"].(string))
+	if err != nil {
+		return nil, errors.New("Argument 'publicKey' should be a public key.") // nolint
+	}
+	m, err := component.GetMemberByPublicKey(p.Context, s.db.Read(), publicKey)
+	if err != nil {
+		if err == component.ErrReferenceNotFound {
+			return nil, nil
+		}
+		s.log.Error(err)
+		return nil, errInternal
+	}
+	return m, nil
+}
+
+func (s *Server) transaction(p graphql.ResolveParams) (interface{}, error) {
+	ref, err := insolar.NewReferenceFromString(p.Args["txID"].(string))
+	if err != nil {
+		return nil, errors.New("Argument 'txID' should be a transaction reference.") // nolint
+	}
+	tx, err := component.GetTx(p.Context, s.db.Read(), ref.Bytes())
+	if err != nil {
+		if err == component.ErrTxNotFound {
+			return nil, nil
+		}
+		s.log.Error(err)
+		return nil, errInternal
+	}
+	return *tx, nil
+}
+
+func (s *Server) networkStats(p graphql.ResolveParams) (interface{}, error) {
+	stats := &models.NetworkStats{}
+	if err := s.db.Read().ModelContext(p.Context, stats).Last(); err != nil {
+		if err == pg.ErrNoRows {
+			return nil, nil
+		}
+		s.log.Error(errors.Wrap(err, "failed to select network stats"))
+		return nil, errInternal
+	}
+	return stats, nil
+}
+
+func (s *Server) supplyStats(p graphql.ResolveParams) (interface{}, error) {
+	stats := &models.SupplyStats{}
+	err := s.db.Read().ModelContext(p.Context, stats).
+		Where("created < now()").
+		Order("created DESC").
+		Limit(1).
+		Select()
+	if err != nil {
+		if err == pg.ErrNoRows {
+			return nil, nil
+		}
+		s.log.Error(errors.Wrap(err, "failed to select supply stats"))
+		return nil, errInternal
+	}
+	return stats, nil
+}
@@ -8,7 +8,6 @@ import (
 	"strings"
 	"time"
 
-	"github.com/go-pg/pg"
 	"github.com/go-pg/pg/orm"
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/logicrunner/builtin/foundation"
@@ -19,18 +18,27 @@ import (
 	"github.com/insolar/observer/component"
 	"github.com/insolar/observer/configuration"
 	"github.com/insolar/observer/internal/app/observer"
+	"github.com/insolar/observer/internal/dbconn"
 	"github.com/insolar/observer/internal/models"
 )
 
 type ObserverServer struct {
-	db       *pg.DB
+	db       *dbconn.Pool
 	log      insolar.Logger
 	pStorage observer.PulseStorage
 	config   configuration.APIConfig
+	cursors  CursorCodec
 }
 
-func NewObserverServer(db *pg.DB, log insolar.Logger, pStorage observer.PulseStorage, config configuration.APIConfig) *ObserverServer {
-	return &ObserverServer{db: db, log: log, pStorage: pStorage, config: config}
+func NewObserverServer(db *dbconn.Pool, log insolar.Logger, pStorage observer.PulseStorage, config configuration.APIConfig) *ObserverServer {
+	if config.GetCursorSecret() == "" {
+		log.Warn("cursor secret is not set, cursors will be invalid after restart and on other API instances")
+	}
+	cursors, err := NewCursorCodec(config.GetCursorSecret())
+	if err != nil {
+		log.Fatal(err)
+	}
+	return &ObserverServer{db: db, log: log, pStorage: pStorage, config: config, cursors: cursors}
 }
 
 func (s *ObserverServer) PulseNumber(ctx echo.Context) error {
@@ -81,37 +89,54 @@ func (s *ObserverServer) ClosedTransactions(ctx echo.Context, params ClosedTrans
 		return ctx.JSON(http.StatusBadRequest, NewSingleMessageError("`limit` should be in range [1, 1000]"))
 	}
 
-	var (
-		pulseNumber    int64
-		sequenceNumber int64
-		err            error
-	)
-	if params.Index != nil {
-		pulseNumber, sequenceNumber, err = checkIndex(*params.Index)
-		if err != nil {
-			return ctx.JSON(http.StatusBadRequest, NewSingleMessageError(err.Error()))
-		}
+	var errorMsg ErrorMessage
+	filters := url.Values{}
+	// The page and the last pulse are read from the same replica, see setImmutable below.
+	db := s.db.Read()
+	query := db.Model(&models.Transaction{}).
+		Where("status_finished = ?0 and status_registered = ?0", true)
+	query = s.filterByTime(ctx, query, &errorMsg, filters, models.TxIndexTypeFinishPulseRecord)
+	p, err := newPager(ctx, s.cursors, "closed", filters, params.Index, params.Order, models.TxIndexTypeFinishPulseRecord, limit)
+	if err != nil {
+		errorMsg.Error = append(errorMsg.Error, err.Error())
+	}
+	if len(errorMsg.Error) > 0 {
+		return ctx.JSON(http.StatusBadRequest, errorMsg)
 	}
 
 	var result []models.Transaction
-	query := s.db.Model(&models.Transaction{}).
-		Where("status_finished = ?0 and status_registered = ?0", true)
-	query, err = component.OrderByIndex(query, params.Order, pulseNumber, sequenceNumber, params.Index != nil, models.TxIndexTypeFinishPulseRecord)
+	query, err = p.apply(query)
 	if err != nil {
-		return ctx.JSON(http.StatusBadRequest, NewSingleMessageError(err.Error()))
+		s.log.Error(err)
+		s.setExpire(ctx, 1*time.Second)
+		return ctx.JSON(http.StatusInternalServerError, struct{}{})
 	}
-	err = query.
-		Limit(limit).
-		Select(&result)
+	err = query.Select(&result)
 	if err != nil {
 		s.log.Error(err)
 		s.setExpire(ctx, 1*time.Second)
 		return ctx.JSON(http.StatusInternalServerError, struct{}{})
 	}
+	result = p.page(ctx, result)
+	if err := component.SetPulseDates(ctx.Request().Context(), db, result); err != nil {
+		s.log.Error(err)
+		s.setExpire(ctx, 1*time.Second)
+		return ctx.JSON(http.StatusInternalServerError, struct{}{})
+	}
 
 	if len(result) == 0 {
 		return ctx.NoContent(http.StatusNoContent)
 	}
+	// Transactions are closed in new pulses, so a full page by an index of a stored pulse never changes.
+	// Pages by an index of a future pulse still get transactions of the next pulses.
+	if params.Index != nil && !p.withTotal && len(result) == limit {
+		last, err := component.GetLastPulseNumber(ctx.Request().Context(), db)
+		if err != nil {
+			s.log.Error(err)
+		} else if p.pulse <= last {
+			s.setImmutable(ctx)
+		}
+	}
 
 	resJSON := make([]interface{}, len(result))
 	for i := 0; i < len(result); i++ {
@@ -159,11 +184,11 @@ func (s *ObserverServer) getMember(ctx echo.Context, method int, smth string) er
 		if refErr != nil {
 			panic("invalid reference")
 		}
-		member, err = component.GetMember(ctx.Request().Context(), s.db, ref.Bytes())
+		member, err = component.GetMember(ctx.Request().Context(), s.db.Read(), ref.Bytes())
 	case getByMigrationAddress:
-		member, err = component.GetMemberByMigrationAddress(ctx.Request().Context(), s.db, smth)
+		member, err = component.GetMemberByMigrationAddress(ctx.Request().Context(), s.db.Read(), smth)
 	case getByPublicKey:
-		member, err = component.GetMemberByPublicKey(ctx.Request().Context(), s.db, smth)
+		member, err = component.GetMemberByPublicKey(ctx.Request().Context(), s.db.Read(), smth)
 	}
 
 	if member != nil {
@@ -177,7 +202,7 @@ func (s *ObserverServer) getMember(ctx echo.Context, method int, smth string) er
 		return ctx.JSON(http.StatusInternalServerError, struct{}{})
 	}
 
-	deposits, err := component.GetDeposits(ctx.Request().Context(), s.db, memberReference, true)
+	deposits, err := component.GetDeposits(ctx.Request().Context(), s.db.Read(), memberReference, true)
 	if err != nil {
 		s.log.Error(err)
 		return ctx.JSON(http.StatusInternalServerError, struct{}{})
@@ -185,7 +210,7 @@ func (s *ObserverServer) getMember(ctx echo.Context, method int, smth string) er
 
 	var burnedBalance *models.BurnedBalance
 	if insolar.NewReferenceFromBytes(member.Reference).Equal(appfoundation.GetMigrationAdminMember()) {
-		burnedBalance, err = component.GetBurnedBalance(s.db)
+		burnedBalance, err = component.GetBurnedBalance(s.db.Read())
 		if err != nil {
 			s.log.Error(err)
 			return ctx.JSON(http.StatusInternalServerError, struct{}{})
@@ -208,7 +233,7 @@ func (s *ObserverServer) Balance(ctx echo.Context, reference string) error {
 		return ctx.JSON(http.StatusBadRequest, *errMsg)
 	}
 
-	member, err := component.GetMemberBalance(ctx.Request().Context(), s.db, ref.Bytes())
+	member, err := component.GetMemberBalance(ctx.Request().Context(), s.db.Read(), ref.Bytes())
 	if err != nil {
 		if err == component.ErrReferenceNotFound {
 			return ctx.NoContent(http.StatusNoContent)
@@ -236,21 +261,29 @@ func (s *ObserverServer) MemberTransactions(ctx echo.Context, reference string,
 	var errorMsg ErrorMessage
 
 	var txs []models.Transaction
-	query := s.db.Model(&txs)
+	query := s.db.Read().Model(&txs)
+	filters := url.Values{}
 
 	query, err := component.FilterByMemberReferenceAndDirection(query, ref, params.Direction)
 	if err != nil {
 		errorMsg.Error = append(errorMsg.Error, err.Error())
 	}
+	if params.Direction != nil {
+		filters.Set("direction", *params.Direction)
+	}
 
-	query = s.getTransactions(query, &errorMsg, params.Status, params.Type, params.Index, params.Order)
+	query = s.getTransactions(query, &errorMsg, filters, params.Status, params.Type)
+	query = s.filterByTime(ctx, query, &errorMsg, filters, models.TxIndexTypePulseRecord)
+	p, err := newPager(ctx, s.cursors, "member/"+ref.String(), filters, params.Index, params.Order, models.TxIndexTypePulseRecord, limit)
+	if err != nil {
+		errorMsg.Error = append(errorMsg.Error, err.Error())
+	}
 
 	if len(errorMsg.Error) > 0 {
 		return ctx.JSON(http.StatusBadRequest, errorMsg)
 	}
 
-	err = query.Limit(limit).Select()
-
+	txs, err = s.selectTransactions(ctx, query, p)
 	if err != nil {
 		s.log.Error(err)
 		return ctx.JSON(http.StatusInternalServerError, struct{}{})
@@ -288,7 +321,8 @@ func (s *ObserverServer) TransactionsByPulseNumberRange(ctx echo.Context, params
 
 	var txs []models.Transaction
 	var err error
-	query := s.db.Model(&txs)
+	query := s.db.Read().Model(&txs)
+	filters := url.Values{}
 
 	if ref != nil {
 		direction := "all"
@@ -296,22 +330,28 @@ func (s *ObserverServer) TransactionsByPulseNumberRange(ctx echo.Context, params
 		if err != nil {
 			errorMsg.Error = append(errorMsg.Error, err.Error())
 		}
+		filters.Set("memberReference", ref.String())
 	}
 
 	query, err = component.FilterByPulse(query, params.FromPulseNumber, params.ToPulseNumber)
 	if err != nil {
 		errorMsg.Error = append(errorMsg.Error, err.Error())
 	}
+	filters.Set("fromPulseNumber", strconv.FormatInt(params.FromPulseNumber, 10))
+	filters.Set("toPulseNumber", strconv.FormatInt(params.ToPulseNumber, 10))
+	query = s.filterByTime(ctx, query, &errorMsg, filters, models.TxIndexTypePulseRecord)
 
-	order := "chronological"
-	s.getTransactionsOrderByIndex(query, errMsg, params.Index, &order)
+	order := orderChronological
+	p, err := newPager(ctx, s.cursors, "pulse/range", filters, params.Index, &order, models.TxIndexTypePulseRecord, limit)
+	if err != nil {
+		errorMsg.Error = append(errorMsg.Error, err.Error())
+	}
 
 	if len(errorMsg.Error) > 0 {
 		return ctx.JSON(http.StatusBadRequest, errorMsg)
 	}
 
-	err = query.Limit(limit).Select()
-
+	txs, err = s.selectTransactions(ctx, query, p)
 	if err != nil {
 		s.log.Error(err)
 		return ctx.JSON(http.StatusInternalServerError, struct{}{})
@@ -347,7 +387,7 @@ func (s *ObserverServer) Transaction(ctx echo.Context, txIDStr string) error {
 		return ctx.JSON(http.StatusBadRequest, NewSingleMessageError("tx_id wrong format"))
 	}
 
-	tx, err := component.GetTx(ctx.Request().Context(), s.db, txID.Bytes())
+	tx, err := component.GetTx(ctx.Request().Context(), s.db.Read(), txID.Bytes())
 	if err != nil {
 		if err == component.ErrTxNotFound {
 			return ctx.NoContent(http.StatusNoContent)
@@ -356,7 +396,13 @@ func (s *ObserverServer) Transaction(ctx echo.Context, txIDStr string) error {
 		return ctx.JSON(http.StatusInternalServerError, struct{}{})
 	}
 
-	return ctx.JSON(http.StatusOK, TxToAPITx(*tx, models.TxIndexTypePulseRecord))
+	txs := []models.Transaction{*tx}
+	if err := component.SetPulseDates(ctx.Request().Context(), s.db.Read(), txs); err != nil {
+		s.log.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, struct{}{})
+	}
+
+	return ctx.JSON(http.StatusOK, TxToAPITx(txs[0], models.TxIndexTypePulseRecord))
 }
 
 func (s *ObserverServer) TransactionsSearch(ctx echo.Context, params TransactionsSearchParams) error {
@@ -371,21 +417,28 @@ func (s *ObserverServer) TransactionsSearch(ctx echo.Context, params Transaction
 	var err error
 
 	var txs []models.Transaction
-	query := s.db.Model(&txs)
+	query := s.db.Read().Model(&txs)
+	filters := url.Values{}
 
 	if params.Value != nil {
 		query, err = component.FilterByValue(query, *params.Value)
 		if err != nil {
 			errorMsg.Error = append(errorMsg.Error, err.Error())
 		}
+		filters.Set("value", *params.Value)
 	}
 
-	query = s.getTransactions(query, &errorMsg, params.Status, params.Type, params.Index, params.Order)
+	query = s.getTransactions(query, &errorMsg, filters, params.Status, params.Type)
+	query = s.filterByTime(ctx, query, &errorMsg, filters, models.TxIndexTypePulseRecord)
+	p, err := newPager(ctx, s.cursors, "transactions", filters, params.Index, params.Order, models.TxIndexTypePulseRecord, limit)
+	if err != nil {
+		errorMsg.Error = append(errorMsg.Error, err.Error())
+	}
 	if len(errorMsg.Error) > 0 {
 		return ctx.JSON(http.StatusBadRequest, errorMsg)
 	}
-	err = query.Limit(params.Limit).Select()
 
+	txs, err = s.selectTransactions(ctx, query, p)
 	if err != nil {
 		s.log.Error(err)
 		return ctx.JSON(http.StatusInternalServerError, struct{}{})
@@ -420,8 +473,9 @@ func checkIndex(i string) (int64, int64, error) {
 	return pulseNumber, sequenceNumber, nil
 }
 
+// getTransactions filters by status and type, values are added to filters to bind cursors to them.
 func (s *ObserverServer) getTransactions(
-	query *orm.Query, errorMsg *ErrorMessage, status, typeParam, index, order *string,
+	query *orm.Query, errorMsg *ErrorMessage, filters url.Values, status, typeParam *string,
 ) *orm.Query {
 	var err error
 	st := "registered"
@@ -432,40 +486,60 @@ func (s *ObserverServer) getTransactions(
 	if err != nil {
 		errorMsg.Error = append(errorMsg.Error, err.Error())
 	}
+	filters.Set("status", st)
 
 	if typeParam != nil {
 		query, err = component.FilterByType(query, *typeParam)
 		if err != nil {
 			errorMsg.Error = append(errorMsg.Error, err.Error())
 		}
+		filters.Set("type", *typeParam)
 	}
-
-	return s.getTransactionsOrderByIndex(query, errorMsg, index, order)
+	return query
 }
 
-func (s *ObserverServer) getTransactionsOrderByIndex(
-	query *orm.Query, errorMsg *ErrorMessage, index, order *string,
+// filterByTime applies optional `fromTimestamp` and `toTimestamp` unix timestamps, they are resolved to pulses
+// with real pulse dates. Values are added to filters to bind cursors to them.
+func (s *ObserverServer) filterByTime(
+	ctx echo.Context, query *orm.Query, errorMsg *ErrorMessage, filters url.Values, indexType models.TxIndexType,
 ) *orm.Query {
-	var err error
-	var pulseNumber int64
-	var sequenceNumber int64
-	byIndex := false
-	if index != nil {
-		pulseNumber, sequenceNumber, err = checkIndex(*index)
+	var bounds [2]*int64
+	for i, name := range []string{"fromTimestamp", "toTimestamp"} {
+		value := ctx.QueryParam(name)
+		if value == "" {
+			continue
+		}
+		timestamp, err := strconv.ParseInt(value, 10, 64)
 		if err != nil {
-			errorMsg.Error = append(errorMsg.Error, err.Error())
-		} else {
-			byIndex = true
+			errorMsg.Error = append(errorMsg.Error, fmt.Sprintf("Query parameter '%s' should be unix timestamp.", name))
+			continue
 		}
+		bounds[i] = &timestamp
+		filters.Set(name, value)
 	}
-
-	query, err = component.OrderByIndex(query, order, pulseNumber, sequenceNumber, byIndex, models.TxIndexTypePulseRecord)
+	query, err := component.FilterByTimestamp(query, bounds[0], bounds[1], indexType)
 	if err != nil {
 		errorMsg.Error = append(errorMsg.Error, err.Error())
 	}
 	return query
 }
 
+func (s *ObserverServer) selectTransactions(ctx echo.Context, query *orm.Query, p *pager) ([]models.Transaction, error) {
+	query, err := p.apply(query)
+	if err != nil {
+		return nil, err
+	}
+	var txs []models.Transaction
+	if err := query.Select(&txs); err != nil {
+		return nil, err
+	}
+	txs = p.page(ctx, txs)
+	if err := component.SetPulseDates(ctx.Request().Context(), s.db.Read(), txs); err != nil {
+		return nil, err
+	}
+	return txs, nil
+}
+
 func (s *ObserverServer) checkReference(referenceRow string) (*insolar.Reference, *ErrorMessage) {
 	referenceRow = strings.TrimSpace(referenceRow)
 	var errMsg ErrorMessage
@@ -489,6 +563,18 @@ func (s *ObserverServer) checkReference(referenceRow string) (*insolar.Reference
 	return ref, nil
 }
 
+// immutableMaxAge is a year, the longest max-age caches are expected to honour.
+const immutableMaxAge = 365 * 24 * time.Hour
+
+// setImmutable lets clients and proxies cache the response for a year without revalidation.
+func (s *ObserverServer) setImmutable(ctx echo.Context) {
+	s.setExpire(ctx, immutableMaxAge)
+	ctx.Response().Header().Set(
+		"Cache-Control",
+		fmt.Sprintf("public, max-age=%d, immutable", int(immutableMaxAge.Seconds())),
+	)
+}
+
 func (s *ObserverServer) setExpire(ctx echo.Context, duration time.Duration) {
 	ctx.Response().Header().Set(
 		"Cache-Control",
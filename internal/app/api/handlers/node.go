@@ -3,16 +3,44 @@
 package handlers
 
 import (
+	"context"
+
 	"github.com/deepmap/oapi-codegen/pkg/runtime"
-	"github.com/go-pg/pg"
 	"github.com/insolar/insolar/insolar"
 
 	"github.com/insolar/observer/configuration"
 	"github.com/insolar/observer/internal/app/api"
+	"github.com/insolar/observer/internal/app/api/auth"
+	"github.com/insolar/observer/internal/app/api/cache"
+	"github.com/insolar/observer/internal/app/api/graph"
+	"github.com/insolar/observer/internal/app/api/ratelimit"
+	"github.com/insolar/observer/internal/app/api/webhooks"
 	"github.com/insolar/observer/internal/app/observer"
+	"github.com/insolar/observer/internal/dbconn"
 )
 
-func RegisterHandlers(router runtime.EchoRouter, db *pg.DB, log insolar.Logger, pStorage observer.PulseStorage, config configuration.APIConfig) {
+func RegisterHandlers(
+	router runtime.EchoRouter, db *dbconn.Pool, log insolar.Logger, pStorage observer.PulseStorage,
+	config configuration.APIConfig, authn *auth.Authenticator, limiter *ratelimit.Limiter, responses *cache.Cache,
+) {
+	public := auth.Router(router, authn.Require(auth.ScopePublic), limiter.Limit, responses.Cache)
+	export := auth.Router(router, authn.Require(auth.ScopeExport), limiter.Limit)
+
 	observerAPI := api.NewObserverServer(db, log, pStorage, config)
-	api.RegisterHandlers(router, observerAPI)
+	api.RegisterHandlers(public, observerAPI)
+	api.RegisterStatementHandler(export, observerAPI)
+	api.RegisterDepositHandlers(public, observerAPI)
+	api.RegisterPulseHandlers(public, observerAPI)
+	api.RegisterRecordHandlers(public, observerAPI)
+
+	stream := api.NewStream(db, log)
+	go stream.Run(context.Background())
+	api.RegisterStreamHandler(public, stream)
+
+	graph.RegisterHandler(public, graph.NewServer(db, log, config))
+	api.RegisterBatchHandlers(public, api.NewBatch(db, log, config))
+
+	hooks := webhooks.New(db, log, config.GetWebhooks())
+	go hooks.Run(context.Background())
+	webhooks.RegisterHandlers(router, hooks, webhooksAuth(authn, hooks))
 }
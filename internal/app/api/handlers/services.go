@@ -3,20 +3,76 @@
 package handlers
 
 import (
+	"context"
+	"strings"
+
 	"github.com/deepmap/oapi-codegen/pkg/runtime"
-	"github.com/go-pg/pg"
 	"github.com/insolar/insolar/insolar"
+	"github.com/labstack/echo/v4"
 
 	"github.com/insolar/observer/configuration"
 	"github.com/insolar/observer/internal/app/api"
+	"github.com/insolar/observer/internal/app/api/auth"
+	"github.com/insolar/observer/internal/app/api/cache"
+	"github.com/insolar/observer/internal/app/api/graph"
+	"github.com/insolar/observer/internal/app/api/notifications"
+	"github.com/insolar/observer/internal/app/api/ratelimit"
 	"github.com/insolar/observer/internal/app/api/services"
+	"github.com/insolar/observer/internal/app/api/webhooks"
 	"github.com/insolar/observer/internal/app/observer"
+	"github.com/insolar/observer/internal/dbconn"
 )
 
-func RegisterHandlers(router runtime.EchoRouter, db *pg.DB, log insolar.Logger, pStorage observer.PulseStorage, config configuration.APIConfig) {
+func RegisterHandlers(
+	router runtime.EchoRouter, db *dbconn.Pool, log insolar.Logger, pStorage observer.PulseStorage,
+	config configuration.APIConfig, authn *auth.Authenticator, limiter *ratelimit.Limiter, responses *cache.Cache,
+) {
+	public := auth.Router(router, authn.Require(auth.ScopePublic), limiter.Limit, responses.Cache)
+	export := auth.Router(router, authn.Require(auth.ScopeExport), limiter.Limit)
+
 	externalObserverAPI := api.NewObserverServerExtended(db, log, pStorage, config)
-	api.RegisterHandlers(router, externalObserverAPI)
+	api.RegisterHandlers(public, externalObserverAPI)
+	api.RegisterStatementHandler(export, externalObserverAPI)
+	api.RegisterDepositHandlers(public, externalObserverAPI)
+	api.RegisterPulseHandlers(public, externalObserverAPI)
+	api.RegisterRecordHandlers(public, externalObserverAPI)
+
+	notes := notifications.New(db, log)
+	notifications.RegisterHandlers(public, notes)
+	if authn.Enabled() {
+		api.RegisterFeeHandlers(router, externalObserverAPI, authn.Require(auth.ScopeAdmin))
+		notifications.RegisterAdminHandlers(router, notes, authn.Require(auth.ScopeAdmin))
+	}
+
+	stream := api.NewStream(db, log)
+	go stream.Run(context.Background())
+	api.RegisterStreamHandler(public, stream)
+
+	graph.RegisterHandler(public, graph.NewServer(db, log, config))
+	api.RegisterBatchHandlers(public, api.NewBatch(db, log, config))
+
+	hooks := webhooks.New(db, log, config.GetWebhooks())
+	go hooks.Run(context.Background())
+	webhooks.RegisterHandlers(router, hooks, webhooksAuth(authn, hooks))
 
 	internalObserverAPI := services.NewObserverServer(db, log, pStorage, config)
-	services.RegisterHandlers(router, internalObserverAPI)
+	servicesRouter := auth.Router(router, servicesAuth(authn), limiter.Limit, responses.Cache)
+	services.RegisterHandlers(servicesRouter, internalObserverAPI)
+	services.RegisterHistoryHandlers(servicesRouter, internalObserverAPI)
+	services.RegisterSupplyHandlers(servicesRouter, internalObserverAPI)
+	services.RegisterHoldersHandlers(servicesRouter, internalObserverAPI)
+}
+
+// servicesAuth requires admin scope for /admin endpoints of the services API, its stats endpoints are public.
+func servicesAuth(authn *auth.Authenticator) echo.MiddlewareFunc {
+	public, admin := authn.Require(auth.ScopePublic), authn.Require(auth.ScopeAdmin)
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		publicNext, adminNext := public(next), admin(next)
+		return func(ctx echo.Context) error {
+			if strings.HasPrefix(ctx.Path(), "/admin/") {
+				return adminNext(ctx)
+			}
+			return publicNext(ctx)
+		}
+	}
 }
@@ -5,6 +5,7 @@ package api
 import (
 	"encoding/json"
 	"fmt"
+	"math/big"
 	"net/http"
 	"net/url"
 	"strings"
@@ -13,28 +14,37 @@ import (
 
 	"github.com/insolar/mainnet/application/appfoundation"
 
-	"github.com/go-pg/pg"
 	"github.com/insolar/insolar/insolar"
 	"github.com/labstack/echo/v4"
 	"github.com/pkg/errors"
 
 	"github.com/insolar/observer/component"
 	"github.com/insolar/observer/configuration"
+	"github.com/insolar/observer/internal/app/api/fees"
 	"github.com/insolar/observer/internal/app/observer"
 	"github.com/insolar/observer/internal/app/observer/postgres"
+	"github.com/insolar/observer/internal/dbconn"
 )
 
 type ObserverServerExtended struct {
-	db       *pg.DB
+	db       *dbconn.Pool
 	log      insolar.Logger
 	pStorage observer.PulseStorage
 	config   configuration.APIConfig
 	server   *ObserverServer
+	fees     *fees.Calculator
 }
 
-func NewObserverServerExtended(db *pg.DB, log insolar.Logger, pStorage observer.PulseStorage, config configuration.APIConfig) *ObserverServerExtended {
+func NewObserverServerExtended(db *dbconn.Pool, log insolar.Logger, pStorage observer.PulseStorage, config configuration.APIConfig) *ObserverServerExtended {
 	observerServer := NewObserverServer(db, log, pStorage, config)
-	return &ObserverServerExtended{db: db, log: log, pStorage: pStorage, config: config, server: observerServer}
+	return &ObserverServerExtended{
+		db:       db,
+		log:      log,
+		pStorage: pStorage,
+		config:   config,
+		server:   observerServer,
+		fees:     fees.NewCalculator(db, config.GetFeeAmount(), config.GetFeeCacheTTL()),
+	}
 }
 
 func (s *ObserverServerExtended) AugmentedAddress(ctx echo.Context, reference string) error {
@@ -42,7 +52,7 @@ func (s *ObserverServerExtended) AugmentedAddress(ctx echo.Context, reference st
 	if errMsg != nil {
 		return ctx.JSON(http.StatusBadRequest, NewSingleMessageError("invalid reference"))
 	}
-	addr, err := component.GetAugmentedAddress(ctx.Request().Context(), s.db, ref.Bytes())
+	addr, err := component.GetAugmentedAddress(ctx.Request().Context(), s.db.Read(), ref.Bytes())
 	if err != nil {
 		if err == component.ErrReferenceNotFound {
 			return ctx.JSON(http.StatusOK, ResponsesAugmentedAddressYaml{AugmentedAddress: ""})
@@ -81,7 +91,7 @@ func (s *ObserverServerExtended) SetAugmentedAddress(ctx echo.Context, reference
 		return ctx.JSON(http.StatusBadRequest, NewSingleMessageError("invalid reference"))
 	}
 
-	member, err := component.GetMember(ctx.Request().Context(), s.db, ref.Bytes())
+	member, err := component.GetMember(ctx.Request().Context(), s.db.Primary(), ref.Bytes())
 	if err != nil {
 		if err == component.ErrReferenceNotFound {
 			return ctx.NoContent(http.StatusNoContent)
@@ -96,7 +106,7 @@ func (s *ObserverServerExtended) SetAugmentedAddress(ctx echo.Context, reference
 		return ctx.JSON(http.StatusForbidden, NewSingleMessageError("signature is wrong"))
 	}
 
-	err = component.SetAugmentedAddress(s.db, *ref, request.AugmentedAddress)
+	err = component.SetAugmentedAddress(s.db.Primary(), *ref, request.AugmentedAddress)
 	if err != nil {
 		s.log.Error(err)
 		return ctx.JSON(http.StatusInternalServerError, struct{}{})
@@ -114,14 +124,24 @@ func (s *ObserverServerExtended) Fee(ctx echo.Context, amount string) error {
 	if strings.HasPrefix(amount, "-") {
 		return ctx.JSON(http.StatusBadRequest, NewSingleMessageError("negative amount"))
 	}
+	value, ok := new(big.Int).SetString(amount, 10)
+	if !ok {
+		return ctx.JSON(http.StatusBadRequest, NewSingleMessageError("invalid amount"))
+	}
 
-	return ctx.JSON(http.StatusOK, ResponsesFeeYaml{Fee: s.config.GetFeeAmount().String()})
+	fee, err := s.fees.Fee(ctx.Request().Context(), value, time.Now())
+	if err != nil {
+		s.log.Error(err)
+		s.setExpire(ctx, 1*time.Second)
+		return ctx.JSON(http.StatusInternalServerError, struct{}{})
+	}
+	return ctx.JSON(http.StatusOK, ResponsesFeeYaml{Fee: fee.String()})
 }
 
 func (s *ObserverServerExtended) Notification(ctx echo.Context) error {
 	s.setExpire(ctx, 1*time.Minute)
 
-	res, err := component.GetNotification(ctx.Request().Context(), s.db)
+	res, err := component.GetNotification(ctx.Request().Context(), s.db.Read())
 	if err != nil {
 		if err == component.ErrNotificationNotFound {
 			return ctx.NoContent(http.StatusNoContent)
@@ -139,7 +159,7 @@ func (s *ObserverServerExtended) Notification(ctx echo.Context) error {
 func (s *ObserverServerExtended) SupplyStatsTotal(ctx echo.Context) error {
 	s.setExpire(ctx, 10*time.Second)
 
-	repo := postgres.NewSupplyStatsRepository(s.db)
+	repo := postgres.NewSupplyStatsRepository(s.db.Read())
 	result, err := repo.LastStats()
 	if err != nil && err == postgres.ErrNoStats {
 		return ctx.JSON(http.StatusNoContent, "")
@@ -193,6 +213,38 @@ func (s *ObserverServerExtended) Transaction(ctx echo.Context, txIDStr string) e
 	return s.server.Transaction(ctx, txIDStr)
 }
 
+func (s *ObserverServerExtended) Statement(ctx echo.Context, reference string) error {
+	return s.server.Statement(ctx, reference)
+}
+
+func (s *ObserverServerExtended) Deposit(ctx echo.Context, reference string) error {
+	return s.server.Deposit(ctx, reference)
+}
+
+func (s *ObserverServerExtended) MemberDeposits(ctx echo.Context, reference string) error {
+	return s.server.MemberDeposits(ctx, reference)
+}
+
+func (s *ObserverServerExtended) DepositsByEthHash(ctx echo.Context, ethHash string) error {
+	return s.server.DepositsByEthHash(ctx, ethHash)
+}
+
+func (s *ObserverServerExtended) PulseAt(ctx echo.Context) error {
+	return s.server.PulseAt(ctx)
+}
+
+func (s *ObserverServerExtended) Pulse(ctx echo.Context, number string) error {
+	return s.server.Pulse(ctx, number)
+}
+
+func (s *ObserverServerExtended) Record(ctx echo.Context, id string) error {
+	return s.server.Record(ctx, id)
+}
+
+func (s *ObserverServerExtended) Object(ctx echo.Context, reference string) error {
+	return s.server.Object(ctx, reference)
+}
+
 func (s *ObserverServerExtended) setExpire(ctx echo.Context, duration time.Duration) {
 	ctx.Response().Header().Set(
 		"Cache-Control",
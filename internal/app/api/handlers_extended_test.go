@@ -171,6 +171,33 @@ func TestObserverServer_Notifications(t *testing.T) {
 	require.NotEqual(t, futureNotificationName, jsonResp.Notification)
 }
 
+func TestObserverServer_NotificationPriority(t *testing.T) {
+	_, err := db.Exec("DELETE FROM notifications")
+	require.NoError(t, err)
+	defer func() {
+		_, err := db.Exec("DELETE FROM notifications")
+		require.NoError(t, err)
+	}()
+
+	for _, n := range []models.Notification{
+		{Message: "low", Priority: 1},
+		{Message: "high", Priority: 10},
+		{Message: "wallet only", Priority: 100, Audience: "wallet"},
+	} {
+		n.Start, n.Stop = time.Now().Add(-time.Hour), time.Now().Add(time.Hour)
+		require.NoError(t, db.Insert(&n))
+	}
+
+	resp, err := http.Get("http://" + apihost + "/api/notification")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	bodyBytes, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	jsonResp := ResponsesNotificationInfoYaml{}
+	require.NoError(t, json.Unmarshal(bodyBytes, &jsonResp))
+	require.Equal(t, "high", jsonResp.Notification)
+}
+
 const (
 	Digest      = "Digest"
 	Signature   = "Signature"
@@ -1,13 +1,16 @@
 package api
 
 import (
+	"bufio"
 	"context"
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -16,6 +19,7 @@ import (
 	"github.com/insolar/insolar/insolar/gen"
 	"github.com/insolar/insolar/insolar/secrets"
 	"github.com/insolar/insolar/logicrunner/builtin/foundation"
+	"github.com/insolar/insolar/pulse"
 	"github.com/insolar/mainnet/application/appfoundation"
 	"github.com/stretchr/testify/require"
 
@@ -529,6 +533,11 @@ func insertDeposit(
 	require.NoError(t, err)
 }
 
+func insertPulse(t *testing.T, number int64, timestamp int64) {
+	err := db.Insert(&models.Pulse{Pulse: uint32(number), PulseDate: timestamp * time.Second.Nanoseconds()})
+	require.NoError(t, err)
+}
+
 func insertBurnedBalance(t *testing.T, balance string) {
 	burnedBalance := models.BurnedBalance{
 		Balance:      balance,
@@ -2070,3 +2079,158 @@ func TestPulseRange_PulseNumber(t *testing.T) {
 	require.Equal(t, int64(secondPulse.Number), received[0])
 	require.Equal(t, int64(thirdPulse.Number), received[1])
 }
+
+func TestMemberTransactions_Cursor(t *testing.T) {
+	defer truncateDB(t)
+
+	member1 := gen.Reference()
+	member2 := gen.Reference()
+	pulseNumber := gen.PulseNumber()
+	txIDs := []insolar.Reference{gen.RecordReference(), gen.RecordReference(), gen.RecordReference()}
+	for i, txID := range txIDs {
+		insertTransactionForMembers(t, txID.Bytes(), int64(pulseNumber), int64(pulseNumber)+10, int64(1234+i), member1, member2)
+	}
+
+	get := func(query string) (*http.Response, []SchemasTransactionAbstract) {
+		resp, err := http.Get("http://" + apihost + "/api/member/" + url.QueryEscape(member1.String()) + "/transactions?" + query)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		received := []SchemasTransactionAbstract{}
+		bodyBytes, err := ioutil.ReadAll(resp.Body)
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(bodyBytes, &received))
+		return resp, received
+	}
+
+	resp, first := get("limit=2&order=chronological&total=true")
+	require.Len(t, first, 2)
+	require.Equal(t, txIDs[0].String(), first[0].TxID)
+	require.Equal(t, txIDs[1].String(), first[1].TxID)
+	require.Equal(t, "3", resp.Header.Get("X-Total-Count"))
+	require.Empty(t, resp.Header.Get("X-Prev-Cursor"))
+	next := resp.Header.Get("X-Next-Cursor")
+	require.NotEmpty(t, next)
+	require.Contains(t, resp.Header.Get("Link"), `rel="next"`)
+
+	resp, second := get("limit=2&order=chronological&cursor=" + next)
+	require.Len(t, second, 1)
+	require.Equal(t, txIDs[2].String(), second[0].TxID)
+	require.Empty(t, resp.Header.Get("X-Next-Cursor"))
+	prev := resp.Header.Get("X-Prev-Cursor")
+	require.NotEmpty(t, prev)
+
+	_, back := get("limit=2&order=chronological&cursor=" + prev)
+	require.Equal(t, first, back)
+
+	// Cursor can't be used with other filters.
+	resp, err := http.Get("http://" + apihost + "/api/member/" + url.QueryEscape(member1.String()) + "/transactions?" +
+		"limit=2&order=reverse&cursor=" + next)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestMemberStatement(t *testing.T) {
+	defer truncateDB(t)
+
+	member1 := gen.Reference()
+	member2 := gen.Reference()
+	insertMember(t, member1, nil, nil, "1000", randomString())
+
+	ts := int64(1600000000)
+	pn := int64(pulse.OfUnixTime(ts))
+	insertTx := func(from, to insolar.Reference, pulseNum, record int64, amount, fee string) {
+		err := db.Insert(&models.Transaction{
+			TransactionID:       gen.RecordReference().Bytes(),
+			Type:                models.TTypeTransfer,
+			PulseRecord:         [2]int64{pulseNum, record},
+			MemberFromReference: from.Bytes(),
+			MemberToReference:   to.Bytes(),
+			Amount:              amount,
+			Fee:                 fee,
+			StatusRegistered:    true,
+			StatusSent:          true,
+			StatusFinished:      true,
+			FinishSuccess:       true,
+			FinishPulseRecord:   [2]int64{pulseNum + 10, record},
+		})
+		require.NoError(t, err)
+	}
+	insertTx(member2, member1, pn, 1, "30", "1")
+	insertTx(member1, member2, pn, 2, "10", "1")
+	// After the statement range.
+	insertTx(member1, member2, pn+100000, 1, "5", "1")
+	// Real pulse dates differ from the approximate ones.
+	insertPulse(t, pn, ts+5)
+	insertPulse(t, pn+100000, ts+100)
+
+	resp, err := http.Get(fmt.Sprintf("http://%s/api/member/%s/statement?from=%d&to=%d&format=csv",
+		apihost, url.QueryEscape(member1.String()), ts-10, ts+10))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "0.0000000987", resp.Header.Get("X-Opening-Balance"))
+	require.Equal(t, "0.0000001006", resp.Header.Get("X-Closing-Balance"))
+
+	rows, err := csv.NewReader(resp.Body).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, rows, 3)
+	require.Equal(t, statementCSVHeader, rows[0])
+	require.Equal(t, time.Unix(ts+5, 0).UTC().Format(time.RFC3339), rows[1][2])
+	require.Equal(t, "incoming", rows[1][6])
+	require.Equal(t, "0.0000001017", rows[1][10])
+	require.Equal(t, "outgoing", rows[2][6])
+	require.Equal(t, "0.0000000001", rows[2][9])
+	require.Equal(t, "0.0000001006", rows[2][10])
+
+	resp, err = http.Get(fmt.Sprintf("http://%s/api/member/%s/statement?from=%d&to=%d&format=xml",
+		apihost, url.QueryEscape(member1.String()), ts-10, ts+10))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestStreamTransactions(t *testing.T) {
+	defer truncateDB(t)
+
+	member1 := gen.Reference()
+	member2 := gen.Reference()
+	pulseNumber := gen.PulseNumber()
+	txIDOld := gen.RecordReference()
+	insertTransactionForMembers(t, txIDOld.Bytes(), int64(pulseNumber), int64(pulseNumber)+10, 1, member1, member2)
+
+	resp, err := http.Get("http://" + apihost + "/api/stream/transactions?member=" + url.QueryEscape(member1.String()) +
+		"&index=" + pulseNumber.String() + "%3A0")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	defer resp.Body.Close()
+
+	events := make(chan string)
+	go func() {
+		scanner := bufio.NewScanner(resp.Body)
+		for scanner.Scan() {
+			line := scanner.Text()
+			if strings.HasPrefix(line, "data: ") {
+				events <- strings.TrimPrefix(line, "data: ")
+			}
+		}
+	}()
+	next := func() SchemasTransactionAbstract {
+		select {
+		case data := <-events:
+			received := SchemasTransactionAbstract{}
+			require.NoError(t, json.Unmarshal([]byte(data), &received))
+			return received
+		case <-time.After(10 * time.Second):
+			t.Fatal("no event received")
+		}
+		return SchemasTransactionAbstract{}
+	}
+
+	// Replayed from index.
+	require.Equal(t, txIDOld.String(), next().TxID)
+
+	// Notified by trigger.
+	txIDNew := gen.RecordReference()
+	insertTransactionForMembers(t, txIDNew.Bytes(), int64(pulseNumber), int64(pulseNumber)+10, 2, member2, member1)
+	received := next()
+	require.Equal(t, txIDNew.String(), received.TxID)
+	require.Equal(t, "registered", received.Status)
+}
@@ -8,10 +8,11 @@ import (
 
 	"github.com/insolar/observer/configuration"
 	"github.com/insolar/observer/internal/app/observer"
+	"github.com/insolar/observer/internal/dbconn"
 )
 
 func NewServer(db *pg.DB, log insolar.Logger, pStorage observer.PulseStorage) ServerInterface {
-	return NewObserverServerExtended(db, log, pStorage, configuration.APIExtended{
+	return NewObserverServerExtended(dbconn.NewPool(db, log), log, pStorage, configuration.APIExtended{
 		FeeAmount: testFee,
 		Price:     testPrice,
 	})
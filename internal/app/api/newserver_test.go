@@ -8,8 +8,9 @@ import (
 
 	"github.com/insolar/observer/configuration"
 	"github.com/insolar/observer/internal/app/observer"
+	"github.com/insolar/observer/internal/dbconn"
 )
 
 func NewServer(db *pg.DB, log insolar.Logger, pStorage observer.PulseStorage) ServerInterface {
-	return NewObserverServer(db, log, pStorage, configuration.API{})
+	return NewObserverServer(dbconn.NewPool(db, log), log, pStorage, configuration.API{})
 }
@@ -0,0 +1,345 @@
+// Package notifications serves active notifications and lets admins manage them.
+//
+// Every change made through the admin endpoints is recorded in notification_audit
+// with the authenticated client that made it.
+package notifications
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+	"github.com/go-pg/pg"
+	"github.com/insolar/insolar/insolar"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/insolar/observer/component"
+	"github.com/insolar/observer/internal/app/api"
+	"github.com/insolar/observer/internal/app/api/auth"
+	"github.com/insolar/observer/internal/dbconn"
+	"github.com/insolar/observer/internal/models"
+)
+
+const (
+	maxMessageLength = 4096
+	maxLocaleLength  = 35
+	maxAudienceLen   = 64
+
+	anonymousActor = "anonymous"
+)
+
+type Service struct {
+	db  *dbconn.Pool
+	log insolar.Logger
+}
+
+func New(db *dbconn.Pool, log insolar.Logger) *Service {
+	return &Service{db: db, log: log}
+}
+
+// RegisterHandlers adds the public list of active notifications.
+func RegisterHandlers(router runtime.EchoRouter, s *Service) {
+	router.GET("/api/notifications", s.Active)
+}
+
+// RegisterAdminHandlers adds endpoints managing notifications.
+func RegisterAdminHandlers(router runtime.EchoRouter, s *Service, auth echo.MiddlewareFunc) {
+	router.GET("/admin/notifications", s.List, auth)
+	router.POST("/admin/notifications", s.Create, auth)
+	router.GET("/admin/notifications/:id", s.Get, auth)
+	router.PUT("/admin/notifications/:id", s.Update, auth)
+	router.DELETE("/admin/notifications/:id", s.Delete, auth)
+	router.GET("/admin/notifications/:id/audit", s.Audit, auth)
+}
+
+// NotificationRequest sets the message shown from start till stop unix timestamps.
+// Empty audience means all, empty locale matches any client locale.
+type NotificationRequest struct {
+	Message  string `json:"message"`
+	Start    int64  `json:"start"`
+	Stop     int64  `json:"stop"`
+	Priority int    `json:"priority"`
+	Audience string `json:"audience"`
+	Locale   string `json:"locale"`
+}
+
+type NotificationResponse struct {
+	ID        int64  `json:"id"`
+	Message   string `json:"message"`
+	Start     int64  `json:"start"`
+	Stop      int64  `json:"stop"`
+	Priority  int    `json:"priority"`
+	Audience  string `json:"audience"`
+	Locale    string `json:"locale"`
+	CreatedBy string `json:"createdBy,omitempty"`
+	UpdatedBy string `json:"updatedBy,omitempty"`
+	Created   int64  `json:"created,omitempty"`
+	Updated   int64  `json:"updated,omitempty"`
+}
+
+type AuditResponse struct {
+	ID           int64           `json:"id"`
+	Action       string          `json:"action"`
+	Actor        string          `json:"actor"`
+	Notification json.RawMessage `json:"notification"`
+	Created      int64           `json:"created"`
+}
+
+func notificationResponse(n models.Notification, withAuthors bool) NotificationResponse {
+	res := NotificationResponse{
+		ID:       n.ID,
+		Message:  n.Message,
+		Start:    n.Start.Unix(),
+		Stop:     n.Stop.Unix(),
+		Priority: n.Priority,
+		Audience: n.Audience,
+		Locale:   n.Locale,
+	}
+	if withAuthors {
+		res.CreatedBy = n.CreatedBy
+		res.UpdatedBy = n.UpdatedBy
+		res.Created = n.Created.Unix()
+		res.Updated = n.Updated.Unix()
+	}
+	return res
+}
+
+// Active lists notifications shown now, filtered by `audience` and `locale`, the highest priority first.
+func (s *Service) Active(ctx echo.Context) error {
+	audience := strings.TrimSpace(ctx.QueryParam("audience"))
+	locale := strings.TrimSpace(ctx.QueryParam("locale"))
+	notifications, err := component.GetActiveNotifications(ctx.Request().Context(), s.db.Read(), audience, locale)
+	if err != nil {
+		s.log.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, struct{}{})
+	}
+	ctx.Response().Header().Set("Cache-Control", "max-age=60")
+	res := make([]NotificationResponse, 0, len(notifications))
+	for _, n := range notifications {
+		res = append(res, notificationResponse(n, false))
+	}
+	return ctx.JSON(http.StatusOK, res)
+}
+
+// List responds with all notifications including past and future ones, the latest first.
+func (s *Service) List(ctx echo.Context) error {
+	var notifications []models.Notification
+	err := s.db.Primary().ModelContext(ctx.Request().Context(), &notifications).Order("start DESC", "id DESC").Select()
+	if err != nil {
+		s.log.Error(errors.Wrap(err, "failed to select notifications"))
+		return ctx.JSON(http.StatusInternalServerError, struct{}{})
+	}
+	res := make([]NotificationResponse, 0, len(notifications))
+	for _, n := range notifications {
+		res = append(res, notificationResponse(n, true))
+	}
+	return ctx.JSON(http.StatusOK, res)
+}
+
+func (s *Service) Get(ctx echo.Context) error {
+	id, errMsg := notificationID(ctx)
+	if errMsg != nil {
+		return ctx.JSON(http.StatusBadRequest, *errMsg)
+	}
+	n := models.Notification{}
+	err := s.db.Primary().ModelContext(ctx.Request().Context(), &n).Where("id = ?", id).Select()
+	if err != nil {
+		if err == pg.ErrNoRows {
+			return ctx.NoContent(http.StatusNotFound)
+		}
+		s.log.Error(errors.Wrap(err, "failed to select notification"))
+		return ctx.JSON(http.StatusInternalServerError, struct{}{})
+	}
+	return ctx.JSON(http.StatusOK, notificationResponse(n, true))
+}
+
+func (s *Service) Create(ctx echo.Context) error {
+	n, errMsg := bindNotification(ctx)
+	if errMsg != nil {
+		return ctx.JSON(http.StatusBadRequest, *errMsg)
+	}
+	n.CreatedBy, n.UpdatedBy = actor(ctx), actor(ctx)
+	err := s.db.Primary().RunInTransaction(func(tx *pg.Tx) error {
+		if _, err := tx.ModelContext(ctx.Request().Context(), &n).Returning("*").Insert(); err != nil {
+			return errors.Wrap(err, "failed to insert notification")
+		}
+		return audit(tx, n, models.NotificationActionCreate, n.CreatedBy)
+	})
+	if err != nil {
+		s.log.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, struct{}{})
+	}
+	return ctx.JSON(http.StatusCreated, notificationResponse(n, true))
+}
+
+// Update replaces all fields of the notification.
+func (s *Service) Update(ctx echo.Context) error {
+	id, errMsg := notificationID(ctx)
+	if errMsg != nil {
+		return ctx.JSON(http.StatusBadRequest, *errMsg)
+	}
+	n, errMsg := bindNotification(ctx)
+	if errMsg != nil {
+		return ctx.JSON(http.StatusBadRequest, *errMsg)
+	}
+	n.ID, n.UpdatedBy = id, actor(ctx)
+	err := s.db.Primary().RunInTransaction(func(tx *pg.Tx) error {
+		res, err := tx.ModelContext(ctx.Request().Context(), &n).
+			Set("message = ?message, start = ?start, stop = ?stop, priority = ?priority").
+			Set("audience = ?audience, locale = ?locale, updated_by = ?updated_by, updated = now()").
+			WherePK().
+			Returning("*").
+			Update()
+		if err != nil {
+			return errors.Wrap(err, "failed to update notification")
+		}
+		if res.RowsAffected() == 0 {
+			return pg.ErrNoRows
+		}
+		return audit(tx, n, models.NotificationActionUpdate, n.UpdatedBy)
+	})
+	if err != nil {
+		if errors.Cause(err) == pg.ErrNoRows {
+			return ctx.NoContent(http.StatusNotFound)
+		}
+		s.log.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, struct{}{})
+	}
+	return ctx.JSON(http.StatusOK, notificationResponse(n, true))
+}
+
+// Delete removes the notification, its audit stays.
+func (s *Service) Delete(ctx echo.Context) error {
+	id, errMsg := notificationID(ctx)
+	if errMsg != nil {
+		return ctx.JSON(http.StatusBadRequest, *errMsg)
+	}
+	err := s.db.Primary().RunInTransaction(func(tx *pg.Tx) error {
+		n := models.Notification{ID: id}
+		res, err := tx.ModelContext(ctx.Request().Context(), &n).WherePK().Returning("*").Delete()
+		if err != nil {
+			return errors.Wrap(err, "failed to delete notification")
+		}
+		if res.RowsAffected() == 0 {
+			return pg.ErrNoRows
+		}
+		return audit(tx, n, models.NotificationActionDelete, actor(ctx))
+	})
+	if err != nil {
+		if errors.Cause(err) == pg.ErrNoRows {
+			return ctx.NoContent(http.StatusNotFound)
+		}
+		s.log.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, struct{}{})
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// Audit lists changes of the notification, the oldest first. It works for deleted notifications too.
+func (s *Service) Audit(ctx echo.Context) error {
+	id, errMsg := notificationID(ctx)
+	if errMsg != nil {
+		return ctx.JSON(http.StatusBadRequest, *errMsg)
+	}
+	var records []models.NotificationAudit
+	err := s.db.Primary().ModelContext(ctx.Request().Context(), &records).
+		Where("notification_id = ?", id).
+		Order("id ASC").
+		Select()
+	if err != nil {
+		s.log.Error(errors.Wrap(err, "failed to select notification audit"))
+		return ctx.JSON(http.StatusInternalServerError, struct{}{})
+	}
+	if len(records) == 0 {
+		return ctx.NoContent(http.StatusNotFound)
+	}
+	res := make([]AuditResponse, 0, len(records))
+	for _, r := range records {
+		res = append(res, AuditResponse{
+			ID:           r.ID,
+			Action:       string(r.Action),
+			Actor:        r.Actor,
+			Notification: json.RawMessage(r.Notification),
+			Created:      r.Created.Unix(),
+		})
+	}
+	return ctx.JSON(http.StatusOK, res)
+}
+
+func audit(tx *pg.Tx, n models.Notification, action models.NotificationAction, actor string) error {
+	snapshot, err := json.Marshal(notificationResponse(n, true))
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal notification")
+	}
+	record := models.NotificationAudit{
+		NotificationID: n.ID,
+		Action:         action,
+		Actor:          actor,
+		Notification:   string(snapshot),
+	}
+	if _, err := tx.Model(&record).Insert(); err != nil {
+		return errors.Wrap(err, "failed to insert notification audit")
+	}
+	return nil
+}
+
+// actor is the authenticated client, requests are anonymous only if admin scope is given to anonymous clients.
+func actor(ctx echo.Context) string {
+	if client := auth.Client(ctx); client != "" {
+		return client
+	}
+	return anonymousActor
+}
+
+func notificationID(ctx echo.Context) (int64, *api.ErrorMessage) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		errMsg := api.NewSingleMessageError("Path parameter 'id' should be a number.")
+		return 0, &errMsg
+	}
+	return id, nil
+}
+
+func bindNotification(ctx echo.Context) (models.Notification, *api.ErrorMessage) {
+	req := NotificationRequest{}
+	if err := ctx.Bind(&req); err != nil {
+		errMsg := api.NewSingleMessageError("Request body should be a notification.")
+		return models.Notification{}, &errMsg
+	}
+	return checkNotification(req)
+}
+
+func checkNotification(req NotificationRequest) (models.Notification, *api.ErrorMessage) {
+	var errorMsg api.ErrorMessage
+	n := models.Notification{
+		Message:  strings.TrimSpace(req.Message),
+		Start:    time.Unix(req.Start, 0).UTC(),
+		Stop:     time.Unix(req.Stop, 0).UTC(),
+		Priority: req.Priority,
+		Audience: strings.TrimSpace(req.Audience),
+		Locale:   strings.TrimSpace(req.Locale),
+	}
+	if n.Audience == "" {
+		n.Audience = models.NotificationAudienceAll
+	}
+	if n.Message == "" || len(n.Message) > maxMessageLength {
+		errorMsg.Error = append(errorMsg.Error, "Field 'message' should be a non-empty text up to 4096 bytes.")
+	}
+	if req.Start <= 0 || req.Stop <= req.Start {
+		errorMsg.Error = append(errorMsg.Error, "Fields 'start' and 'stop' should be unix timestamps, 'stop' after 'start'.")
+	}
+	if len(n.Audience) > maxAudienceLen {
+		errorMsg.Error = append(errorMsg.Error, "Field 'audience' should be up to 64 bytes.")
+	}
+	if len(n.Locale) > maxLocaleLength {
+		errorMsg.Error = append(errorMsg.Error, "Field 'locale' should be a language tag like 'en' or 'pt-BR'.")
+	}
+	if len(errorMsg.Error) > 0 {
+		return n, &errorMsg
+	}
+	return n, nil
+}
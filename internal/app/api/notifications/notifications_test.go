@@ -0,0 +1,30 @@
+package notifications
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/observer/internal/models"
+)
+
+func TestCheckNotification(t *testing.T) {
+	n, errMsg := checkNotification(NotificationRequest{Message: " maintenance ", Start: 100, Stop: 200, Locale: "en"})
+	require.Nil(t, errMsg)
+	require.Equal(t, "maintenance", n.Message)
+	require.Equal(t, models.NotificationAudienceAll, n.Audience)
+	require.Equal(t, int64(100), n.Start.Unix())
+
+	for name, req := range map[string]NotificationRequest{
+		"empty message": {Start: 100, Stop: 200},
+		"reversed":      {Message: "m", Start: 200, Stop: 100},
+		"no start":      {Message: "m", Stop: 100},
+		"locale":        {Message: "m", Start: 100, Stop: 200, Locale: "this-is-not-a-language-tag-at-all-really"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, errMsg := checkNotification(req)
+			require.NotNil(t, errMsg)
+			require.Len(t, errMsg.Error, 1)
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package api
+
+import (
+	"encoding/base64"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/pulse"
+	"github.com/labstack/echo/v4"
+
+	"github.com/insolar/observer/component"
+)
+
+// PulseServer resolves time to pulses by their real dates and describes pulses,
+// the endpoints aren't a part of the generated API.
+type PulseServer interface {
+	PulseAt(ctx echo.Context) error
+	Pulse(ctx echo.Context, number string) error
+}
+
+func RegisterPulseHandlers(router runtime.EchoRouter, s PulseServer) {
+	router.GET("/api/pulse/at", s.PulseAt)
+	router.GET("/api/pulse/:number", func(ctx echo.Context) error {
+		return s.Pulse(ctx, ctx.Param("number"))
+	})
+}
+
+// ResponsesPulse is the pulse number with the unix time of the pulse.
+type ResponsesPulse struct {
+	PulseNumber int64 `json:"pulseNumber"`
+	Timestamp   int64 `json:"timestamp"`
+}
+
+// ResponsesPulseDetail describes the pulse with its nodes and activity. Records and requests
+// are counted for pulses stored by this version, they are null for older ones.
+type ResponsesPulseDetail struct {
+	ResponsesPulse
+	Entropy      string               `json:"entropy"`
+	NodeCount    int64                `json:"nodeCount"`
+	Nodes        []ResponsesPulseNode `json:"nodes"`
+	Records      *int64               `json:"records"`
+	Requests     *int64               `json:"requests"`
+	Transactions int64                `json:"transactions"`
+}
+
+type ResponsesPulseNode struct {
+	Reference string `json:"reference"`
+	Role      string `json:"role"`
+}
+
+// PulseAt responds with the pulse nearest to the `timestamp` unix time.
+func (s *ObserverServer) PulseAt(ctx echo.Context) error {
+	timestamp, err := strconv.ParseInt(ctx.QueryParam("timestamp"), 10, 64)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewSingleMessageError("Query parameter 'timestamp' should be unix timestamp."))
+	}
+	p, err := component.GetPulseAt(ctx.Request().Context(), s.db.Read(), timestamp)
+	if err != nil {
+		if err == component.ErrPulseNotFound {
+			return ctx.NoContent(http.StatusNoContent)
+		}
+		s.log.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, struct{}{})
+	}
+	return ctx.JSON(http.StatusOK, ResponsesPulse{
+		PulseNumber: int64(p.Pulse),
+		Timestamp:   p.PulseDate / time.Second.Nanoseconds(),
+	})
+}
+
+// Pulse responds with the pulse date, entropy, nodes and the number of records, requests and transactions in it.
+func (s *ObserverServer) Pulse(ctx echo.Context, number string) error {
+	pulseNumber, err := strconv.ParseInt(number, 10, 64)
+	if err != nil || pulseNumber < pulse.MinTimePulse || pulseNumber > pulse.MaxTimePulse {
+		return ctx.JSON(http.StatusBadRequest, NewSingleMessageError("Path parameter 'number' should be a pulse number."))
+	}
+	p, nodes, err := component.GetPulse(ctx.Request().Context(), s.db.Read(), pulseNumber)
+	if err != nil {
+		if err == component.ErrPulseNotFound {
+			return ctx.NoContent(http.StatusNoContent)
+		}
+		s.log.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, struct{}{})
+	}
+	txs, err := component.CountPulseTransactions(ctx.Request().Context(), s.db.Read(), pulseNumber)
+	if err != nil {
+		s.log.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, struct{}{})
+	}
+
+	res := ResponsesPulseDetail{
+		ResponsesPulse: ResponsesPulse{
+			PulseNumber: int64(p.Pulse),
+			Timestamp:   p.PulseDate / time.Second.Nanoseconds(),
+		},
+		Entropy:      base64.StdEncoding.EncodeToString(p.Entropy),
+		NodeCount:    int64(p.Nodes),
+		Nodes:        make([]ResponsesPulseNode, 0, len(nodes)),
+		Records:      p.Records,
+		Requests:     p.Requests,
+		Transactions: txs,
+	}
+	for _, node := range nodes {
+		res.Nodes = append(res.Nodes, ResponsesPulseNode{
+			Reference: insolar.NewReferenceFromBytes(node.Reference).String(),
+			Role:      node.Role,
+		})
+	}
+	return ctx.JSON(http.StatusOK, res)
+}
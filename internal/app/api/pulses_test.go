@@ -0,0 +1,111 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/observer/internal/app/observer"
+)
+
+func TestPulseAt(t *testing.T) {
+	defer truncateDB(t)
+
+	ts := int64(1600000000)
+	insertPulse(t, 65537, ts)
+	insertPulse(t, 65547, ts+100)
+
+	for timestamp, expected := range map[int64]int64{ts - 1000: 65537, ts + 40: 65537, ts + 60: 65547, ts + 1000: 65547} {
+		resp, err := http.Get(fmt.Sprintf("http://%s/api/pulse/at?timestamp=%d", apihost, timestamp))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		received := ResponsesPulse{}
+		bodyBytes, err := ioutil.ReadAll(resp.Body)
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(bodyBytes, &received))
+		require.Equal(t, expected, received.PulseNumber)
+	}
+
+	resp, err := http.Get("http://" + apihost + "/api/pulse/at?timestamp=yesterday")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestTransactionsSearch_Timestamp(t *testing.T) {
+	defer truncateDB(t)
+
+	ts := int64(1600000000)
+	pulseNumber := int64(gen.PulseNumber())
+	txIDFirst := gen.RecordReference()
+	txIDSecond := gen.RecordReference()
+	insertTransaction(t, txIDFirst.Bytes(), pulseNumber, pulseNumber+10, 1)
+	insertTransaction(t, txIDSecond.Bytes(), pulseNumber+20, pulseNumber+30, 1)
+	insertPulse(t, pulseNumber, ts)
+	insertPulse(t, pulseNumber+20, ts+100)
+
+	resp, err := http.Get(fmt.Sprintf("http://%s/api/transactions?limit=10&fromTimestamp=%d&toTimestamp=%d", apihost, ts+50, ts+100))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	bodyBytes, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	var received []SchemasTransactionAbstract
+	require.NoError(t, json.Unmarshal(bodyBytes, &received))
+	require.Len(t, received, 1)
+	require.Equal(t, txIDSecond.String(), received[0].TxID)
+	require.Equal(t, ts+100, received[0].Timestamp)
+
+	resp, err = http.Get(fmt.Sprintf("http://%s/api/transactions?limit=10&fromTimestamp=%d&toTimestamp=%d", apihost, ts+50, ts))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestPulse(t *testing.T) {
+	defer truncateDB(t)
+
+	pulseNumber := gen.PulseNumber()
+	virtual, heavy := gen.Reference(), gen.Reference()
+	require.NoError(t, pStorage.Insert(&observer.Pulse{
+		Number:    pulseNumber,
+		Timestamp: 1600000000 * time.Second.Nanoseconds(),
+		Nodes: []insolar.Node{
+			{ID: virtual, Role: insolar.StaticRoleVirtual},
+			{ID: heavy, Role: insolar.StaticRoleHeavyMaterial},
+		},
+		Records:  10,
+		Requests: 4,
+	}))
+	insertTransaction(t, gen.RecordReference().Bytes(), int64(pulseNumber), int64(pulseNumber)+10, 1)
+	insertTransaction(t, gen.RecordReference().Bytes(), int64(pulseNumber)+10, int64(pulseNumber)+20, 1)
+
+	resp, err := http.Get(fmt.Sprintf("http://%s/api/pulse/%d", apihost, pulseNumber))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	bodyBytes, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	received := ResponsesPulseDetail{}
+	require.NoError(t, json.Unmarshal(bodyBytes, &received))
+	require.Equal(t, int64(pulseNumber), received.PulseNumber)
+	require.Equal(t, int64(1600000000), received.Timestamp)
+	require.Equal(t, int64(2), received.NodeCount)
+	require.Len(t, received.Nodes, 2)
+	require.Equal(t, heavy.String(), received.Nodes[0].Reference)
+	require.Equal(t, "heavy_material", received.Nodes[0].Role)
+	require.Equal(t, int64(10), *received.Records)
+	require.Equal(t, int64(4), *received.Requests)
+	require.Equal(t, int64(1), received.Transactions)
+
+	resp, err = http.Get(fmt.Sprintf("http://%s/api/pulse/%d", apihost, pulseNumber+1))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	resp, err = http.Get("http://" + apihost + "/api/pulse/123")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
@@ -0,0 +1,88 @@
+package ratelimit
+
+import (
+	"context"
+	"net"
+	"strconv"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/insolar/observer/internal/app/api/auth"
+)
+
+// UnaryInterceptor is Limit for gRPC, it must run after the auth interceptor.
+// Methods are classified by their full names, e.g. `/rpc.Observer/SearchTransactions` in ExpensiveRoutes.
+// Limited calls fail with ResourceExhausted and `retry-after` header.
+func (l *Limiter) UnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := l.limitCall(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// StreamInterceptor is UnaryInterceptor for streaming calls, a token is taken when the stream starts.
+func (l *Limiter) StreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := l.limitCall(ss.Context(), info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+// UnaryIPInterceptor is LimitIP for gRPC, it must run before the auth interceptor.
+func (l *Limiter) UnaryIPInterceptor(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if l.cfg.Enabled && l.cfg.IPRate > 0 {
+		retry := l.allow(ctx, ClassIP+":"+peerIP(ctx), clientAny, ClassIP, l.cfg.IPRate, l.cfg.IPBurst)
+		if err := exhausted(ctx, retry); err != nil {
+			return nil, err
+		}
+	}
+	return handler(ctx, req)
+}
+
+// StreamIPInterceptor is UnaryIPInterceptor for streaming calls.
+func (l *Limiter) StreamIPInterceptor(srv interface{}, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if l.cfg.Enabled && l.cfg.IPRate > 0 {
+		retry := l.allow(ss.Context(), ClassIP+":"+peerIP(ss.Context()), clientAny, ClassIP, l.cfg.IPRate, l.cfg.IPBurst)
+		if err := exhausted(ss.Context(), retry); err != nil {
+			return err
+		}
+	}
+	return handler(srv, ss)
+}
+
+func (l *Limiter) limitCall(ctx context.Context, method string) error {
+	if !l.cfg.Enabled {
+		return nil
+	}
+	client := auth.ClientFromContext(ctx)
+	label := client
+	if client == "" {
+		client, label = "ip:"+peerIP(ctx), clientAnonymous
+	}
+	class, rate, burst := l.class(method)
+	return exhausted(ctx, l.allow(ctx, class+":"+client, label, class, rate, burst))
+}
+
+func exhausted(ctx context.Context, retry int) error {
+	if retry == 0 {
+		return nil
+	}
+	_ = grpc.SetHeader(ctx, metadata.Pairs("retry-after", strconv.Itoa(retry)))
+	return status.Error(codes.ResourceExhausted, "Too many requests.")
+}
+
+func peerIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
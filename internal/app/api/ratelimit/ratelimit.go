@@ -0,0 +1,289 @@
+// Package ratelimit limits API requests per client with token buckets.
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-pg/pg"
+	"github.com/insolar/insolar/insolar"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/insolar/observer/configuration"
+	"github.com/insolar/observer/internal/app/api"
+	"github.com/insolar/observer/internal/app/api/auth"
+	"github.com/insolar/observer/internal/dbconn"
+)
+
+const (
+	ClassDefault   = "default"
+	ClassExpensive = "expensive"
+	ClassIP        = "ip"
+
+	resultAllowed = "allowed"
+	resultLimited = "limited"
+
+	// clientAnonymous labels requests without credentials, they are limited by IP, which isn't a label to keep metrics small.
+	clientAnonymous = "anonymous"
+	// clientAny labels requests limited by IP before authentication.
+	clientAny = "any"
+
+	// defaultIdleTimeout is used if IdleTimeout isn't set, idle buckets are checked as often.
+	defaultIdleTimeout = 10 * time.Minute
+)
+
+// Limiter takes a token from the client bucket of the route class for every request.
+// Clients are identified by auth.Client, so Limit must run after authentication, or by IP.
+// LimitIP takes a token from the IP bucket before authentication.
+type Limiter struct {
+	db      *dbconn.Pool
+	log     insolar.Logger
+	cfg     configuration.RateLimit
+	proxies []*net.IPNet
+
+	mu      sync.Mutex
+	buckets map[string]*bucket
+
+	requests *prometheus.CounterVec
+}
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// take refills the bucket for the time passed and takes a token if there is one.
+// Otherwise it returns how long to wait for the next token.
+func (b *bucket) take(now time.Time, rate float64, burst int) (bool, time.Duration) {
+	b.tokens = math.Min(float64(burst), b.tokens+now.Sub(b.last).Seconds()*rate)
+	b.last = now
+	if b.tokens >= 1 {
+		b.tokens--
+		return true, 0
+	}
+	return false, wait(b.tokens, rate)
+}
+
+func wait(tokens, rate float64) time.Duration {
+	return time.Duration((1 - tokens) / rate * float64(time.Second))
+}
+
+func New(db *dbconn.Pool, log insolar.Logger, cfg configuration.RateLimit) *Limiter {
+	if cfg.IdleTimeout <= 0 {
+		cfg.IdleTimeout = defaultIdleTimeout
+	}
+	var proxies []*net.IPNet
+	for _, proxy := range cfg.TrustedProxies {
+		if !strings.Contains(proxy, "/") {
+			if ip := net.ParseIP(proxy); ip != nil && ip.To4() != nil {
+				proxy += "/32"
+			} else {
+				proxy += "/128"
+			}
+		}
+		_, network, err := net.ParseCIDR(proxy)
+		if err != nil {
+			// Skipping it only trusts less.
+			log.Error(errors.Wrapf(err, "invalid trusted proxy %q", proxy))
+			continue
+		}
+		proxies = append(proxies, network)
+	}
+	return &Limiter{
+		db:      db,
+		log:     log,
+		cfg:     cfg,
+		proxies: proxies,
+		buckets: make(map[string]*bucket),
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "observer_api_ratelimit_requests_total",
+			Help: "API requests by authenticated client or anonymous, route class and rate limit result",
+		}, []string{"client", "class", "result"}),
+	}
+}
+
+// Collector exports per client usage.
+func (l *Limiter) Collector() prometheus.Collector {
+	return l.requests
+}
+
+// Run drops buckets of idle clients until ctx is done.
+func (l *Limiter) Run(ctx context.Context) {
+	if !l.cfg.Enabled {
+		return
+	}
+	ticker := time.NewTicker(l.cfg.IdleTimeout)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		idle := time.Now().Add(-l.cfg.IdleTimeout)
+		l.mu.Lock()
+		for name, b := range l.buckets {
+			if b.last.Before(idle) {
+				delete(l.buckets, name)
+			}
+		}
+		l.mu.Unlock()
+		if l.cfg.Shared {
+			_, err := l.db.Primary().ExecContext(ctx, `delete from rate_limits where updated < ?`, idle)
+			if err != nil {
+				l.log.Error(errors.Wrap(err, "failed to delete idle rate limits"))
+			}
+		}
+	}
+}
+
+// Limit responds with 429 and Retry-After if the client bucket is empty.
+func (l *Limiter) Limit(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		if !l.cfg.Enabled {
+			return next(ctx)
+		}
+		client := auth.Client(ctx)
+		label := client
+		if client == "" {
+			client, label = "ip:"+l.clientIP(ctx.Request()), clientAnonymous
+		}
+		class, rate, burst := l.class(ctx.Path())
+		return l.limit(ctx, next, class+":"+client, label, class, rate, burst)
+	}
+}
+
+// LimitIP limits every IP before authentication, so requests with invalid credentials don't reach the db unlimited.
+// It must run before authentication, for example as a global middleware.
+func (l *Limiter) LimitIP(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		if !l.cfg.Enabled || l.cfg.IPRate <= 0 {
+			return next(ctx)
+		}
+		return l.limit(ctx, next, ClassIP+":"+l.clientIP(ctx.Request()), clientAny, ClassIP, l.cfg.IPRate, l.cfg.IPBurst)
+	}
+}
+
+// clientIP is the remote address of the request.
+// Forwarding headers are set by anyone, they are used only if the request came from a trusted proxy:
+// the client is the rightmost X-Forwarded-For address that isn't a trusted proxy, or X-Real-IP.
+func (l *Limiter) clientIP(req *http.Request) string {
+	ip, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		ip = req.RemoteAddr
+	}
+	if !l.trusted(ip) {
+		return ip
+	}
+	if forwarded := req.Header.Get(echo.HeaderXForwardedFor); forwarded != "" {
+		hops := strings.Split(forwarded, ",")
+		for i := len(hops) - 1; i >= 0; i-- {
+			ip = strings.TrimSpace(hops[i])
+			if !l.trusted(ip) {
+				break
+			}
+		}
+		return ip
+	}
+	if realIP := req.Header.Get(echo.HeaderXRealIP); realIP != "" {
+		return realIP
+	}
+	return ip
+}
+
+func (l *Limiter) trusted(addr string) bool {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return false
+	}
+	for _, network := range l.proxies {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+func (l *Limiter) limit(ctx echo.Context, next echo.HandlerFunc, name, label, class string, rate float64, burst int) error {
+	if retry := l.allow(ctx.Request().Context(), name, label, class, rate, burst); retry > 0 {
+		ctx.Response().Header().Set("Retry-After", strconv.Itoa(retry))
+		return ctx.JSON(http.StatusTooManyRequests, api.NewSingleMessageError("Too many requests."))
+	}
+	return next(ctx)
+}
+
+// allow takes a token and counts the request, it returns seconds to retry after if the bucket is empty.
+func (l *Limiter) allow(ctx context.Context, name, label, class string, rate float64, burst int) int {
+	allowed, retry := l.take(ctx, name, rate, burst)
+	if !allowed {
+		l.requests.WithLabelValues(label, class, resultLimited).Inc()
+		seconds := int(math.Ceil(retry.Seconds()))
+		if seconds < 1 {
+			seconds = 1
+		}
+		return seconds
+	}
+	l.requests.WithLabelValues(label, class, resultAllowed).Inc()
+	return 0
+}
+
+func (l *Limiter) class(path string) (string, float64, int) {
+	for _, route := range l.cfg.ExpensiveRoutes {
+		if path == route || (strings.HasSuffix(route, "*") && strings.HasPrefix(path, strings.TrimSuffix(route, "*"))) {
+			return ClassExpensive, l.cfg.ExpensiveRate, l.cfg.ExpensiveBurst
+		}
+	}
+	return ClassDefault, l.cfg.Rate, l.cfg.Burst
+}
+
+func (l *Limiter) take(ctx context.Context, name string, rate float64, burst int) (bool, time.Duration) {
+	if l.cfg.Shared {
+		allowed, retry, err := l.takeShared(ctx, name, rate, burst)
+		if err == nil {
+			return allowed, retry
+		}
+		// Better to limit per instance than to fail requests.
+		l.log.Error(err)
+	}
+
+	now := time.Now()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	b, ok := l.buckets[name]
+	if !ok {
+		b = &bucket{tokens: float64(burst), last: now}
+		l.buckets[name] = b
+	}
+	return b.take(now, rate, burst)
+}
+
+// refilled is the bucket after refill, set expressions see the old row.
+const refilled = `least(?1::float8, rate_limits.tokens + extract(epoch from now() - rate_limits.updated) * ?2::float8)`
+
+func (l *Limiter) takeShared(ctx context.Context, name string, rate float64, burst int) (bool, time.Duration, error) {
+	var allowed bool
+	var tokens float64
+	_, err := l.db.Primary().QueryOneContext(ctx, pg.Scan(&allowed, &tokens), `
+		insert into rate_limits (bucket, tokens, allowed, updated) values (?0, ?1::float8 - 1, true, now())
+		on conflict (bucket) do update set
+			tokens = `+refilled+` - case when `+refilled+` >= 1 then 1 else 0 end,
+			allowed = `+refilled+` >= 1,
+			updated = now()
+		returning allowed, tokens`,
+		name, burst, rate)
+	if err != nil {
+		return false, 0, errors.Wrap(err, "failed to take shared rate limit token")
+	}
+	if allowed {
+		return true, 0, nil
+	}
+	return false, wait(tokens, rate), nil
+}
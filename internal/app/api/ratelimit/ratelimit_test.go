@@ -0,0 +1,182 @@
+package ratelimit
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/insolar/observer/configuration"
+)
+
+func TestBucket(t *testing.T) {
+	now := time.Now()
+	b := bucket{tokens: 2, last: now}
+
+	ok, _ := b.take(now, 1, 2)
+	require.True(t, ok)
+	ok, _ = b.take(now, 1, 2)
+	require.True(t, ok)
+	ok, retry := b.take(now, 1, 2)
+	require.False(t, ok)
+	require.Equal(t, time.Second, retry)
+
+	// Refill doesn't exceed burst.
+	ok, _ = b.take(now.Add(time.Hour), 1, 2)
+	require.True(t, ok)
+	require.Equal(t, float64(1), b.tokens)
+}
+
+func TestLimit(t *testing.T) {
+	cfg := configuration.RateLimit{
+		Enabled:         true,
+		Rate:            1,
+		Burst:           3,
+		ExpensiveRoutes: []string{"/api/transactions", "/api/stats/*"},
+		ExpensiveRate:   0.5,
+		ExpensiveBurst:  1,
+		IdleTimeout:     time.Minute,
+	}
+	limiter := New(nil, inslogger.FromContext(context.Background()), cfg)
+
+	e := echo.New()
+	ok := func(ctx echo.Context) error { return ctx.NoContent(http.StatusOK) }
+	e.GET("/api/pulse/number", ok, limiter.Limit)
+	e.GET("/api/transactions", ok, limiter.Limit)
+	e.GET("/api/stats/network", ok, limiter.Limit)
+
+	get := func(path, ip string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.RemoteAddr = ip + ":1234"
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+
+	for i := 0; i < cfg.Burst; i++ {
+		require.Equal(t, http.StatusOK, get("/api/pulse/number", "10.0.0.1").Code)
+	}
+	rec := get("/api/pulse/number", "10.0.0.1")
+	require.Equal(t, http.StatusTooManyRequests, rec.Code)
+	require.Equal(t, "1", rec.Header().Get("Retry-After"))
+
+	// Expensive routes have their own bucket.
+	require.Equal(t, http.StatusOK, get("/api/transactions", "10.0.0.1").Code)
+	rec = get("/api/stats/network", "10.0.0.1")
+	require.Equal(t, http.StatusTooManyRequests, rec.Code)
+	require.Equal(t, "2", rec.Header().Get("Retry-After"))
+
+	// Other clients aren't affected.
+	require.Equal(t, http.StatusOK, get("/api/pulse/number", "10.0.0.2").Code)
+
+	// IPs aren't labels.
+	require.Equal(t, 4, testutil.CollectAndCount(limiter.Collector()))
+	require.Equal(t, float64(cfg.Burst+1), testutil.ToFloat64(limiter.requests.WithLabelValues(clientAnonymous, ClassDefault, resultAllowed)))
+}
+
+func TestLimitIP(t *testing.T) {
+	cfg := configuration.RateLimit{Enabled: true, Rate: 1, Burst: 10, IPRate: 1, IPBurst: 2, IdleTimeout: time.Minute}
+	limiter := New(nil, inslogger.FromContext(context.Background()), cfg)
+
+	e := echo.New()
+	e.Use(limiter.LimitIP)
+	unauthorized := func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error { return ctx.NoContent(http.StatusUnauthorized) }
+	}
+	e.GET("/api/pulse/number", func(ctx echo.Context) error { return ctx.NoContent(http.StatusOK) }, unauthorized, limiter.Limit)
+
+	get := func(ip string) int {
+		req := httptest.NewRequest(http.MethodGet, "/api/pulse/number", nil)
+		req.RemoteAddr = ip + ":1234"
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	// Rejected credentials are limited too.
+	require.Equal(t, http.StatusUnauthorized, get("10.0.0.1"))
+	require.Equal(t, http.StatusUnauthorized, get("10.0.0.1"))
+	require.Equal(t, http.StatusTooManyRequests, get("10.0.0.1"))
+	require.Equal(t, http.StatusUnauthorized, get("10.0.0.2"))
+}
+
+func TestLimitIP_ForwardedFor(t *testing.T) {
+	cfg := configuration.RateLimit{Enabled: true, IPRate: 1, IPBurst: 1, TrustedProxies: []string{"10.0.0.0/8", "192.168.0.1", "invalid"}}
+	limiter := New(nil, inslogger.FromContext(context.Background()), cfg)
+	require.Len(t, limiter.proxies, 2)
+
+	e := echo.New()
+	e.Use(limiter.LimitIP)
+	e.GET("/api/pulse/number", func(ctx echo.Context) error { return ctx.NoContent(http.StatusOK) })
+
+	get := func(remote, forwarded string) int {
+		req := httptest.NewRequest(http.MethodGet, "/api/pulse/number", nil)
+		req.RemoteAddr = remote + ":1234"
+		req.Header.Set(echo.HeaderXForwardedFor, forwarded)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	// Headers of clients connected directly are ignored.
+	require.Equal(t, http.StatusOK, get("203.0.113.1", "198.51.100.1"))
+	require.Equal(t, http.StatusTooManyRequests, get("203.0.113.1", "198.51.100.2"))
+	require.Equal(t, http.StatusTooManyRequests, get("203.0.113.1", "198.51.100.3, 10.0.0.1"))
+
+	// Behind trusted proxies the client is the rightmost untrusted hop, spoofed hops before it don't matter.
+	require.Equal(t, http.StatusOK, get("10.0.0.1", "198.51.100.1, 203.0.113.2, 192.168.0.1"))
+	require.Equal(t, http.StatusTooManyRequests, get("10.0.0.2", "198.51.100.2, 203.0.113.2"))
+	require.Equal(t, http.StatusOK, get("10.0.0.1", "203.0.113.3"))
+}
+
+func TestUnaryInterceptor(t *testing.T) {
+	cfg := configuration.RateLimit{
+		Enabled:         true,
+		Rate:            1,
+		Burst:           2,
+		ExpensiveRoutes: []string{"/rpc.Observer/SearchTransactions"},
+		ExpensiveRate:   1,
+		ExpensiveBurst:  1,
+		IPRate:          1,
+		IPBurst:         10,
+		IdleTimeout:     time.Minute,
+	}
+	limiter := New(nil, inslogger.FromContext(context.Background()), cfg)
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return nil, nil }
+	call := func(method, ip string) codes.Code {
+		ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 1000}})
+		info := &grpc.UnaryServerInfo{FullMethod: method}
+		_, err := limiter.UnaryIPInterceptor(ctx, nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return limiter.UnaryInterceptor(ctx, req, info, handler)
+		})
+		return status.Code(err)
+	}
+
+	require.Equal(t, codes.OK, call("/rpc.Observer/GetMember", "10.0.0.1"))
+	require.Equal(t, codes.OK, call("/rpc.Observer/GetMember", "10.0.0.1"))
+	require.Equal(t, codes.ResourceExhausted, call("/rpc.Observer/GetMember", "10.0.0.1"))
+	require.Equal(t, codes.OK, call("/rpc.Observer/SearchTransactions", "10.0.0.1"))
+	require.Equal(t, codes.ResourceExhausted, call("/rpc.Observer/SearchTransactions", "10.0.0.1"))
+	require.Equal(t, codes.OK, call("/rpc.Observer/GetMember", "10.0.0.2"))
+}
+
+func TestRun_NoIdleTimeout(t *testing.T) {
+	limiter := New(nil, inslogger.FromContext(context.Background()), configuration.RateLimit{Enabled: true})
+	require.Equal(t, defaultIdleTimeout, limiter.cfg.IdleTimeout)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	limiter.Run(ctx)
+}
@@ -0,0 +1,288 @@
+package api
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/mainnet/application/builtin/contract/account"
+	"github.com/insolar/mainnet/application/builtin/contract/deposit"
+	"github.com/insolar/mainnet/application/builtin/contract/member"
+	"github.com/insolar/mainnet/application/builtin/contract/wallet"
+	proxyAccount "github.com/insolar/mainnet/application/builtin/proxy/account"
+	proxyDeposit "github.com/insolar/mainnet/application/builtin/proxy/deposit"
+	proxyMember "github.com/insolar/mainnet/application/builtin/proxy/member"
+	proxyWallet "github.com/insolar/mainnet/application/builtin/proxy/wallet"
+	"github.com/labstack/echo/v4"
+
+	"github.com/insolar/observer/component"
+)
+
+const (
+	defaultObjectStatesLimit = 100
+	maxObjectStatesLimit     = 1000
+)
+
+// RecordServer decodes stored ledger records and object states, the endpoints aren't a part of the generated API.
+type RecordServer interface {
+	Record(ctx echo.Context, id string) error
+	Object(ctx echo.Context, reference string) error
+}
+
+func RegisterRecordHandlers(router runtime.EchoRouter, s RecordServer) {
+	router.GET("/api/record/:id", func(ctx echo.Context) error {
+		return s.Record(ctx, ctx.Param("id"))
+	})
+	router.GET("/api/object/:reference", func(ctx echo.Context) error {
+		return s.Object(ctx, ctx.Param("reference"))
+	})
+}
+
+// ResponsesRecord is the request with its result and side effect, parts that aren't stored are omitted.
+type ResponsesRecord struct {
+	Request    *ResponsesMaterial `json:"request,omitempty"`
+	Result     *ResponsesMaterial `json:"result,omitempty"`
+	SideEffect *ResponsesMaterial `json:"sideEffect,omitempty"`
+}
+
+// ResponsesMaterial is a decoded record, only the fields of its type are set.
+// Arguments, payload and memory are decoded from the contract serialization, undecodable ones are base64.
+type ResponsesMaterial struct {
+	ID           string      `json:"id"`
+	Type         string      `json:"type"`
+	Method       string      `json:"method,omitempty"`
+	Arguments    interface{} `json:"arguments,omitempty"`
+	Prototype    string      `json:"prototype,omitempty"`
+	Object       string      `json:"object,omitempty"`
+	Caller       string      `json:"caller,omitempty"`
+	Reason       string      `json:"reason,omitempty"`
+	APIRequestID string      `json:"apiRequestID,omitempty"`
+	Request      string      `json:"request,omitempty"`
+	Payload      interface{} `json:"payload,omitempty"`
+	Image        string      `json:"image,omitempty"`
+	Parent       string      `json:"parent,omitempty"`
+	PrevState    string      `json:"prevState,omitempty"`
+	Memory       interface{} `json:"memory,omitempty"`
+}
+
+// ResponsesObject is the state chain of the object from its activation.
+type ResponsesObject struct {
+	Reference string                 `json:"reference"`
+	Prototype string                 `json:"prototype"`
+	States    []ResponsesObjectState `json:"states"`
+}
+
+// ResponsesObjectState is a state of the object, memory is decoded for members, accounts, deposits and wallets.
+type ResponsesObjectState struct {
+	ID        string      `json:"id"`
+	Type      string      `json:"type"`
+	Request   string      `json:"request"`
+	Image     string      `json:"image,omitempty"`
+	PrevState string      `json:"prevState,omitempty"`
+	Memory    interface{} `json:"memory,omitempty"`
+}
+
+// Record responds with the request with the `id` or the request of the side effect with the `id`,
+// together with its result and side effect.
+func (s *ObserverServer) Record(ctx echo.Context, id string) error {
+	ref, errMsg := s.checkReference(id)
+	if errMsg != nil || ref.GetLocal().IsEmpty() {
+		return ctx.JSON(http.StatusBadRequest, NewSingleMessageError("Path parameter 'id' should be a record id."))
+	}
+	rec, err := component.GetRawRecord(ctx.Request().Context(), s.db.Read(), *ref.GetLocal())
+	if err != nil {
+		if err == component.ErrRecordNotFound {
+			return ctx.NoContent(http.StatusNoContent)
+		}
+		s.log.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, struct{}{})
+	}
+	s.setExpire(ctx, 1*time.Second)
+	return ctx.JSON(http.StatusOK, ResponsesRecord{
+		Request:    materialResponse(rec.Request),
+		Result:     materialResponse(rec.Result),
+		SideEffect: materialResponse(rec.SideEffect),
+	})
+}
+
+// Object responds with states of the object, `limit` is 100 by default.
+func (s *ObserverServer) Object(ctx echo.Context, reference string) error {
+	ref, errMsg := s.checkReference(reference)
+	if errMsg != nil {
+		return ctx.JSON(http.StatusBadRequest, errMsg)
+	}
+	limit := defaultObjectStatesLimit
+	if v := ctx.QueryParam("limit"); v != "" {
+		var err error
+		limit, err = strconv.Atoi(v)
+		if err != nil || limit < 1 || limit > maxObjectStatesLimit {
+			return ctx.JSON(http.StatusBadRequest, NewSingleMessageError(
+				fmt.Sprintf("Query parameter 'limit' should be from 1 to %d.", maxObjectStatesLimit)))
+		}
+	}
+	states, err := component.GetObjectStates(ctx.Request().Context(), s.db.Read(), *ref, limit)
+	if err != nil {
+		if err == component.ErrObjectNotFound {
+			return ctx.NoContent(http.StatusNoContent)
+		}
+		s.log.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, struct{}{})
+	}
+	s.setExpire(ctx, 1*time.Second)
+
+	res := ResponsesObject{
+		Reference: insolar.NewReference(*ref.GetLocal()).String(),
+		States:    make([]ResponsesObjectState, 0, len(states)),
+	}
+	for _, st := range states {
+		state := ResponsesObjectState{
+			ID:        st.ObjectID,
+			Type:      st.Type,
+			Request:   st.Request,
+			Image:     st.Image,
+			PrevState: st.PrevState,
+		}
+		if st.Image != "" {
+			res.Prototype = st.Image
+		}
+		if st.Memory != "" {
+			memory, err := hex.DecodeString(st.Memory)
+			if err != nil {
+				s.log.Error(err)
+				return ctx.JSON(http.StatusInternalServerError, struct{}{})
+			}
+			state.Memory = decodeMemory(st.Image, memory)
+		}
+		res.States = append(res.States, state)
+	}
+	return ctx.JSON(http.StatusOK, res)
+}
+
+func materialResponse(mat *record.Material) *ResponsesMaterial {
+	if mat == nil {
+		return nil
+	}
+	res := &ResponsesMaterial{ID: mat.ID.String()}
+	switch v := mat.Virtual.Union.(type) {
+	case *record.Virtual_IncomingRequest:
+		req := v.IncomingRequest
+		res.Type = "incomingRequest"
+		res.Method = req.Method
+		res.Arguments = decodeValue(req.Arguments)
+		res.Prototype = refString(req.Prototype)
+		res.Object = refString(req.Object)
+		res.Caller = refString(&req.Caller)
+		res.Reason = refString(&req.Reason)
+		res.APIRequestID = req.APIRequestID
+	case *record.Virtual_OutgoingRequest:
+		req := v.OutgoingRequest
+		res.Type = "outgoingRequest"
+		res.Method = req.Method
+		res.Arguments = decodeValue(req.Arguments)
+		res.Prototype = refString(req.Prototype)
+		res.Object = refString(req.Object)
+		res.Caller = refString(&req.Caller)
+		res.Reason = refString(&req.Reason)
+		res.APIRequestID = req.APIRequestID
+	case *record.Virtual_Result:
+		res.Type = "result"
+		res.Object = insolar.NewReference(v.Result.Object).String()
+		res.Request = refString(&v.Result.Request)
+		res.Payload = decodeValue(v.Result.Payload)
+	case *record.Virtual_Activate:
+		res.Type = "activate"
+		res.Request = refString(&v.Activate.Request)
+		res.Image = refString(&v.Activate.Image)
+		res.Parent = refString(&v.Activate.Parent)
+		res.Memory = decodeMemory(res.Image, v.Activate.Memory)
+	case *record.Virtual_Amend:
+		res.Type = "amend"
+		res.Request = refString(&v.Amend.Request)
+		res.Image = refString(&v.Amend.Image)
+		res.PrevState = v.Amend.PrevState.String()
+		res.Memory = decodeMemory(res.Image, v.Amend.Memory)
+	case *record.Virtual_Deactivate:
+		res.Type = "deactivate"
+		res.Request = refString(&v.Deactivate.Request)
+		res.PrevState = v.Deactivate.PrevState.String()
+	default:
+		res.Type = "other"
+	}
+	return res
+}
+
+func refString(ref *insolar.Reference) string {
+	if ref == nil || ref.IsEmpty() {
+		return ""
+	}
+	return ref.String()
+}
+
+// decodeMemory decodes memory of known contracts by their prototype, others are decoded as generic values.
+func decodeMemory(image string, memory []byte) interface{} {
+	if len(memory) == 0 {
+		return nil
+	}
+	var state interface{}
+	switch image {
+	case proxyMember.PrototypeReference.String():
+		state = &member.Member{}
+	case proxyAccount.PrototypeReference.String():
+		state = &account.Account{}
+	case proxyDeposit.PrototypeReference.String():
+		state = &deposit.Deposit{}
+	case proxyWallet.PrototypeReference.String():
+		state = &wallet.Wallet{}
+	default:
+		return decodeValue(memory)
+	}
+	if err := insolar.Deserialize(memory, state); err != nil {
+		return memory
+	}
+	return state
+}
+
+// decodeValue decodes serialized arguments and payloads, the bytes are returned as is if they can't be decoded.
+func decodeValue(data []byte) interface{} {
+	if len(data) == 0 {
+		return nil
+	}
+	var v interface{}
+	if err := insolar.Deserialize(data, &v); err != nil {
+		return data
+	}
+	return jsonValue(v)
+}
+
+// jsonValue makes decoded values encodable, byte strings with JSON objects or arrays are embedded as JSON.
+func jsonValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			v[key] = jsonValue(value)
+		}
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			m[fmt.Sprint(key)] = jsonValue(value)
+		}
+		return m
+	case []interface{}:
+		for i, value := range v {
+			v[i] = jsonValue(value)
+		}
+	case []byte:
+		trimmed := bytes.TrimSpace(v)
+		if len(trimmed) > 0 && (trimmed[0] == '{' || trimmed[0] == '[') && json.Valid(trimmed) {
+			return json.RawMessage(trimmed)
+		}
+	}
+	return v
+}
@@ -0,0 +1,153 @@
+package api
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/mainnet/application/builtin/contract/account"
+	proxyAccount "github.com/insolar/mainnet/application/builtin/proxy/account"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/observer/internal/app/observer/postgres"
+)
+
+func truncateRecords(t *testing.T) {
+	_, err := db.Exec("TRUNCATE TABLE raw_requests, raw_results, raw_side_effects, objects")
+	require.NoError(t, err)
+}
+
+func insertMaterial(t *testing.T, query string, id, requestID insolar.ID, virtual record.Virtual) {
+	mat := record.Material{ID: id, Virtual: virtual}
+	body, err := mat.Marshal()
+	require.NoError(t, err)
+	_, err = db.Exec(query, id.String(), requestID.String(), body)
+	require.NoError(t, err)
+}
+
+func TestRecord(t *testing.T) {
+	defer truncateRecords(t)
+
+	requestID, reasonID, sideEffectID := gen.ID(), gen.ID(), gen.ID()
+	insertMaterial(t, `insert into raw_requests (request_id, reason_id, request_body) values (?0, ?1, ?2)`,
+		requestID, reasonID, record.Wrap(&record.IncomingRequest{
+			Method:    "Transfer",
+			Arguments: insolar.MustSerialize([]interface{}{"100", "insolar:1AAEAAQ"}),
+			Prototype: proxyAccount.PrototypeReference,
+			Reason:    *insolar.NewRecordReference(reasonID),
+		}))
+	insertMaterial(t, `insert into raw_side_effects (id, request_id, side_effect_body) values (?0, ?1, ?2)`,
+		sideEffectID, requestID, record.Wrap(&record.Amend{
+			Request: *insolar.NewRecordReference(requestID),
+			Image:   *proxyAccount.PrototypeReference,
+			Memory:  insolar.MustSerialize(account.Account{Balance: "900"}),
+		}))
+
+	for _, id := range []insolar.ID{requestID, sideEffectID} {
+		resp, err := http.Get("http://" + apihost + "/api/record/" + id.String())
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		bodyBytes, err := ioutil.ReadAll(resp.Body)
+		require.NoError(t, err)
+		received := struct {
+			Request    ResponsesMaterial
+			SideEffect struct {
+				Type   string
+				Memory account.Account
+			}
+			Result *ResponsesMaterial
+		}{}
+		require.NoError(t, json.Unmarshal(bodyBytes, &received))
+		require.Equal(t, "incomingRequest", received.Request.Type)
+		require.Equal(t, "Transfer", received.Request.Method)
+		require.Equal(t, []interface{}{"100", "insolar:1AAEAAQ"}, received.Request.Arguments)
+		require.Equal(t, proxyAccount.PrototypeReference.String(), received.Request.Prototype)
+		require.Equal(t, "amend", received.SideEffect.Type)
+		require.Equal(t, "900", received.SideEffect.Memory.Balance)
+		require.Nil(t, received.Result)
+	}
+
+	resp, err := http.Get("http://" + apihost + "/api/record/" + gen.ID().String())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	resp, err = http.Get("http://" + apihost + "/api/record/not-an-id")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestObject(t *testing.T) {
+	defer truncateRecords(t)
+
+	requestID, activateID, amendID := gen.ID(), gen.ID(), gen.ID()
+	image := proxyAccount.PrototypeReference.String()
+	for _, row := range []postgres.ObjectSchema{
+		{
+			ObjectID: insolar.NewReference(activateID).String(),
+			Request:  insolar.NewRecordReference(requestID).String(),
+			Memory:   hex.EncodeToString(insolar.MustSerialize(account.Account{Balance: "0"})),
+			Image:    image,
+			Type:     "ACTIVATE",
+		},
+		{
+			ObjectID:  insolar.NewReference(amendID).String(),
+			Request:   insolar.NewRecordReference(gen.ID()).String(),
+			Memory:    hex.EncodeToString(insolar.MustSerialize(account.Account{Balance: "100"})),
+			Image:     image,
+			PrevState: activateID.String(),
+			Type:      "AMEND",
+		},
+	} {
+		row := row
+		_, err := db.Model(&row).Insert()
+		require.NoError(t, err)
+	}
+
+	resp, err := http.Get("http://" + apihost + "/api/object/" + insolar.NewReference(requestID).String())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	bodyBytes, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	received := struct {
+		Prototype string
+		States    []struct {
+			ID     string
+			Type   string
+			Memory account.Account
+		}
+	}{}
+	require.NoError(t, json.Unmarshal(bodyBytes, &received))
+	require.Equal(t, image, received.Prototype)
+	require.Len(t, received.States, 2)
+	require.Equal(t, "ACTIVATE", received.States[0].Type)
+	require.Equal(t, "0", received.States[0].Memory.Balance)
+	require.Equal(t, insolar.NewReference(amendID).String(), received.States[1].ID)
+	require.Equal(t, "100", received.States[1].Memory.Balance)
+
+	resp, err = http.Get("http://" + apihost + "/api/object/" + insolar.NewReference(requestID).String() + "?limit=1")
+	require.NoError(t, err)
+	bodyBytes, err = ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(bodyBytes, &received))
+	require.Len(t, received.States, 1)
+
+	resp, err = http.Get("http://" + apihost + "/api/object/" + gen.Reference().String())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	resp, err = http.Get("http://" + apihost + "/api/object/" + insolar.NewReference(requestID).String() + "?limit=0")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestJSONValue(t *testing.T) {
+	v := jsonValue([]interface{}{[]byte(`{"method":"member.transfer"}`), []byte{1, 2}, map[interface{}]interface{}{1: "one"}})
+	b, err := json.Marshal(v)
+	require.NoError(t, err)
+	require.JSONEq(t, `[{"method":"member.transfer"},"AQI=",{"1":"one"}]`, string(b))
+}
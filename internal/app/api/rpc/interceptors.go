@@ -0,0 +1,77 @@
+package rpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ReadMethods only read from db, they are served in read-only mode.
+var ReadMethods = []string{
+	"/rpc.Observer/GetMember",
+	"/rpc.Observer/GetBalance",
+	"/rpc.Observer/ListMemberTransactions",
+	"/rpc.Observer/GetTransaction",
+	"/rpc.Observer/SearchTransactions",
+	"/rpc.Observer/GetPulseRange",
+	"/rpc.Observer/WatchTransactions",
+}
+
+// ReadOnly is api.ReadOnly for gRPC, calls of methods other than safe ones fail with Unavailable.
+func ReadOnly(safe ...string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := readOnly(info.FullMethod, safe); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// ReadOnlyStream is ReadOnly for streaming calls.
+func ReadOnlyStream(safe ...string) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := readOnly(info.FullMethod, safe); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+func readOnly(method string, safe []string) error {
+	for _, m := range safe {
+		if m == method {
+			return nil
+		}
+	}
+	return status.Error(codes.Unavailable, "API is in read-only mode because of db schema version mismatch")
+}
+
+// ChainUnary runs interceptors in the given order, the server accepts only one.
+func ChainUnary(interceptors ...grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		next := handler
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			interceptor, h := interceptors[i], next
+			next = func(ctx context.Context, req interface{}) (interface{}, error) {
+				return interceptor(ctx, req, info, h)
+			}
+		}
+		return next(ctx, req)
+	}
+}
+
+// ChainStream is ChainUnary for streaming calls.
+func ChainStream(interceptors ...grpc.StreamServerInterceptor) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		next := handler
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			interceptor, h := interceptors[i], next
+			next = func(srv interface{}, ss grpc.ServerStream) error {
+				return interceptor(srv, ss, info, h)
+			}
+		}
+		return next(srv, ss)
+	}
+}
@@ -0,0 +1,52 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestReadMethods(t *testing.T) {
+	// Every method is a read now, a method that writes must be left out of ReadMethods.
+	var methods []string
+	for _, m := range _Observer_serviceDesc.Methods {
+		methods = append(methods, "/"+_Observer_serviceDesc.ServiceName+"/"+m.MethodName)
+	}
+	for _, s := range _Observer_serviceDesc.Streams {
+		methods = append(methods, "/"+_Observer_serviceDesc.ServiceName+"/"+s.StreamName)
+	}
+	require.ElementsMatch(t, methods, ReadMethods)
+}
+
+func TestChainUnary(t *testing.T) {
+	var calls []string
+	interceptor := func(name string) grpc.UnaryServerInterceptor {
+		return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			calls = append(calls, name)
+			return handler(ctx, req)
+		}
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		calls = append(calls, "handler")
+		return "ok", nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: "/rpc.Observer/Write"}
+
+	res, err := ChainUnary(interceptor("first"), interceptor("second"))(context.Background(), nil, info, handler)
+	require.NoError(t, err)
+	require.Equal(t, "ok", res)
+	require.Equal(t, []string{"first", "second", "handler"}, calls)
+
+	calls = nil
+	_, err = ChainUnary(interceptor("first"), ReadOnly(ReadMethods...))(context.Background(), nil, info, handler)
+	require.Equal(t, codes.Unavailable, status.Code(err))
+	require.Equal(t, []string{"first"}, calls)
+
+	info.FullMethod = "/rpc.Observer/GetMember"
+	_, err = ChainUnary(ReadOnly(ReadMethods...))(context.Background(), nil, info, handler)
+	require.NoError(t, err)
+}
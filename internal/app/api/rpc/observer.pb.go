@@ -0,0 +1,5969 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: internal/app/api/rpc/observer.proto
+
+package rpc
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type GetMemberRequest struct {
+	// Member reference or migration address, ignored if public key is set.
+	Reference string `protobuf:"bytes,1,opt,name=Reference,proto3" json:"Reference,omitempty"`
+	PublicKey string `protobuf:"bytes,2,opt,name=PublicKey,proto3" json:"PublicKey,omitempty"`
+}
+
+func (m *GetMemberRequest) Reset()      { *m = GetMemberRequest{} }
+func (*GetMemberRequest) ProtoMessage() {}
+func (*GetMemberRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8117fb151b39a29f, []int{0}
+}
+func (m *GetMemberRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetMemberRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetMemberRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetMemberRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMemberRequest.Merge(m, src)
+}
+func (m *GetMemberRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetMemberRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMemberRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMemberRequest proto.InternalMessageInfo
+
+func (m *GetMemberRequest) GetReference() string {
+	if m != nil {
+		return m.Reference
+	}
+	return ""
+}
+
+func (m *GetMemberRequest) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+type Member struct {
+	Reference        string `protobuf:"bytes,1,opt,name=Reference,proto3" json:"Reference,omitempty"`
+	WalletReference  string `protobuf:"bytes,2,opt,name=WalletReference,proto3" json:"WalletReference,omitempty"`
+	AccountReference string `protobuf:"bytes,3,opt,name=AccountReference,proto3" json:"AccountReference,omitempty"`
+	Balance          string `protobuf:"bytes,4,opt,name=Balance,proto3" json:"Balance,omitempty"`
+	MigrationAddress string `protobuf:"bytes,5,opt,name=MigrationAddress,proto3" json:"MigrationAddress,omitempty"`
+	// Set only for the migration admin member.
+	BurnedBalance string     `protobuf:"bytes,6,opt,name=BurnedBalance,proto3" json:"BurnedBalance,omitempty"`
+	Deposits      []*Deposit `protobuf:"bytes,7,rep,name=Deposits,proto3" json:"Deposits,omitempty"`
+}
+
+func (m *Member) Reset()      { *m = Member{} }
+func (*Member) ProtoMessage() {}
+func (*Member) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8117fb151b39a29f, []int{1}
+}
+func (m *Member) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Member) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Member.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Member) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Member.Merge(m, src)
+}
+func (m *Member) XXX_Size() int {
+	return m.Size()
+}
+func (m *Member) XXX_DiscardUnknown() {
+	xxx_messageInfo_Member.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Member proto.InternalMessageInfo
+
+func (m *Member) GetReference() string {
+	if m != nil {
+		return m.Reference
+	}
+	return ""
+}
+
+func (m *Member) GetWalletReference() string {
+	if m != nil {
+		return m.WalletReference
+	}
+	return ""
+}
+
+func (m *Member) GetAccountReference() string {
+	if m != nil {
+		return m.AccountReference
+	}
+	return ""
+}
+
+func (m *Member) GetBalance() string {
+	if m != nil {
+		return m.Balance
+	}
+	return ""
+}
+
+func (m *Member) GetMigrationAddress() string {
+	if m != nil {
+		return m.MigrationAddress
+	}
+	return ""
+}
+
+func (m *Member) GetBurnedBalance() string {
+	if m != nil {
+		return m.BurnedBalance
+	}
+	return ""
+}
+
+func (m *Member) GetDeposits() []*Deposit {
+	if m != nil {
+		return m.Deposits
+	}
+	return nil
+}
+
+type Deposit struct {
+	Index            int64  `protobuf:"varint,1,opt,name=Index,proto3" json:"Index,omitempty"`
+	DepositReference string `protobuf:"bytes,2,opt,name=DepositReference,proto3" json:"DepositReference,omitempty"`
+	MemberReference  string `protobuf:"bytes,3,opt,name=MemberReference,proto3" json:"MemberReference,omitempty"`
+	EthTxHash        string `protobuf:"bytes,4,opt,name=EthTxHash,proto3" json:"EthTxHash,omitempty"`
+	AmountOnHold     string `protobuf:"bytes,5,opt,name=AmountOnHold,proto3" json:"AmountOnHold,omitempty"`
+	AvailableAmount  string `protobuf:"bytes,6,opt,name=AvailableAmount,proto3" json:"AvailableAmount,omitempty"`
+	ReleasedAmount   string `protobuf:"bytes,7,opt,name=ReleasedAmount,proto3" json:"ReleasedAmount,omitempty"`
+	HoldReleaseDate  int64  `protobuf:"varint,8,opt,name=HoldReleaseDate,proto3" json:"HoldReleaseDate,omitempty"`
+	ReleaseEndDate   int64  `protobuf:"varint,9,opt,name=ReleaseEndDate,proto3" json:"ReleaseEndDate,omitempty"`
+	Status           string `protobuf:"bytes,10,opt,name=Status,proto3" json:"Status,omitempty"`
+	Timestamp        int64  `protobuf:"varint,11,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+}
+
+func (m *Deposit) Reset()      { *m = Deposit{} }
+func (*Deposit) ProtoMessage() {}
+func (*Deposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8117fb151b39a29f, []int{2}
+}
+func (m *Deposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Deposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Deposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Deposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Deposit.Merge(m, src)
+}
+func (m *Deposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *Deposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_Deposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Deposit proto.InternalMessageInfo
+
+func (m *Deposit) GetIndex() int64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *Deposit) GetDepositReference() string {
+	if m != nil {
+		return m.DepositReference
+	}
+	return ""
+}
+
+func (m *Deposit) GetMemberReference() string {
+	if m != nil {
+		return m.MemberReference
+	}
+	return ""
+}
+
+func (m *Deposit) GetEthTxHash() string {
+	if m != nil {
+		return m.EthTxHash
+	}
+	return ""
+}
+
+func (m *Deposit) GetAmountOnHold() string {
+	if m != nil {
+		return m.AmountOnHold
+	}
+	return ""
+}
+
+func (m *Deposit) GetAvailableAmount() string {
+	if m != nil {
+		return m.AvailableAmount
+	}
+	return ""
+}
+
+func (m *Deposit) GetReleasedAmount() string {
+	if m != nil {
+		return m.ReleasedAmount
+	}
+	return ""
+}
+
+func (m *Deposit) GetHoldReleaseDate() int64 {
+	if m != nil {
+		return m.HoldReleaseDate
+	}
+	return 0
+}
+
+func (m *Deposit) GetReleaseEndDate() int64 {
+	if m != nil {
+		return m.ReleaseEndDate
+	}
+	return 0
+}
+
+func (m *Deposit) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *Deposit) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+type GetBalanceRequest struct {
+	Reference string `protobuf:"bytes,1,opt,name=Reference,proto3" json:"Reference,omitempty"`
+}
+
+func (m *GetBalanceRequest) Reset()      { *m = GetBalanceRequest{} }
+func (*GetBalanceRequest) ProtoMessage() {}
+func (*GetBalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8117fb151b39a29f, []int{3}
+}
+func (m *GetBalanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetBalanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetBalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBalanceRequest.Merge(m, src)
+}
+func (m *GetBalanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetBalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBalanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBalanceRequest proto.InternalMessageInfo
+
+func (m *GetBalanceRequest) GetReference() string {
+	if m != nil {
+		return m.Reference
+	}
+	return ""
+}
+
+type Balance struct {
+	Balance string `protobuf:"bytes,1,opt,name=Balance,proto3" json:"Balance,omitempty"`
+}
+
+func (m *Balance) Reset()      { *m = Balance{} }
+func (*Balance) ProtoMessage() {}
+func (*Balance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8117fb151b39a29f, []int{4}
+}
+func (m *Balance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Balance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Balance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Balance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Balance.Merge(m, src)
+}
+func (m *Balance) XXX_Size() int {
+	return m.Size()
+}
+func (m *Balance) XXX_DiscardUnknown() {
+	xxx_messageInfo_Balance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Balance proto.InternalMessageInfo
+
+func (m *Balance) GetBalance() string {
+	if m != nil {
+		return m.Balance
+	}
+	return ""
+}
+
+type Transaction struct {
+	TxID string `protobuf:"bytes,1,opt,name=TxID,proto3" json:"TxID,omitempty"`
+	// <pulse_number>:<sequence_number>, pass it as index to get the next page.
+	Index                string `protobuf:"bytes,2,opt,name=Index,proto3" json:"Index,omitempty"`
+	Type                 string `protobuf:"bytes,3,opt,name=Type,proto3" json:"Type,omitempty"`
+	Status               string `protobuf:"bytes,4,opt,name=Status,proto3" json:"Status,omitempty"`
+	Amount               string `protobuf:"bytes,5,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	Fee                  string `protobuf:"bytes,6,opt,name=Fee,proto3" json:"Fee,omitempty"`
+	PulseNumber          int64  `protobuf:"varint,7,opt,name=PulseNumber,proto3" json:"PulseNumber,omitempty"`
+	Timestamp            int64  `protobuf:"varint,8,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+	FromMemberReference  string `protobuf:"bytes,9,opt,name=FromMemberReference,proto3" json:"FromMemberReference,omitempty"`
+	ToMemberReference    string `protobuf:"bytes,10,opt,name=ToMemberReference,proto3" json:"ToMemberReference,omitempty"`
+	FromDepositReference string `protobuf:"bytes,11,opt,name=FromDepositReference,proto3" json:"FromDepositReference,omitempty"`
+	ToDepositReference   string `protobuf:"bytes,12,opt,name=ToDepositReference,proto3" json:"ToDepositReference,omitempty"`
+}
+
+func (m *Transaction) Reset()      { *m = Transaction{} }
+func (*Transaction) ProtoMessage() {}
+func (*Transaction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8117fb151b39a29f, []int{5}
+}
+func (m *Transaction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Transaction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Transaction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Transaction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Transaction.Merge(m, src)
+}
+func (m *Transaction) XXX_Size() int {
+	return m.Size()
+}
+func (m *Transaction) XXX_DiscardUnknown() {
+	xxx_messageInfo_Transaction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Transaction proto.InternalMessageInfo
+
+func (m *Transaction) GetTxID() string {
+	if m != nil {
+		return m.TxID
+	}
+	return ""
+}
+
+func (m *Transaction) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+func (m *Transaction) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *Transaction) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *Transaction) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *Transaction) GetFee() string {
+	if m != nil {
+		return m.Fee
+	}
+	return ""
+}
+
+func (m *Transaction) GetPulseNumber() int64 {
+	if m != nil {
+		return m.PulseNumber
+	}
+	return 0
+}
+
+func (m *Transaction) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *Transaction) GetFromMemberReference() string {
+	if m != nil {
+		return m.FromMemberReference
+	}
+	return ""
+}
+
+func (m *Transaction) GetToMemberReference() string {
+	if m != nil {
+		return m.ToMemberReference
+	}
+	return ""
+}
+
+func (m *Transaction) GetFromDepositReference() string {
+	if m != nil {
+		return m.FromDepositReference
+	}
+	return ""
+}
+
+func (m *Transaction) GetToDepositReference() string {
+	if m != nil {
+		return m.ToDepositReference
+	}
+	return ""
+}
+
+type Transactions struct {
+	Transactions []*Transaction `protobuf:"bytes,1,rep,name=Transactions,proto3" json:"Transactions,omitempty"`
+}
+
+func (m *Transactions) Reset()      { *m = Transactions{} }
+func (*Transactions) ProtoMessage() {}
+func (*Transactions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8117fb151b39a29f, []int{6}
+}
+func (m *Transactions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Transactions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Transactions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Transactions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Transactions.Merge(m, src)
+}
+func (m *Transactions) XXX_Size() int {
+	return m.Size()
+}
+func (m *Transactions) XXX_DiscardUnknown() {
+	xxx_messageInfo_Transactions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Transactions proto.InternalMessageInfo
+
+func (m *Transactions) GetTransactions() []*Transaction {
+	if m != nil {
+		return m.Transactions
+	}
+	return nil
+}
+
+type ListMemberTransactionsRequest struct {
+	Reference string `protobuf:"bytes,1,opt,name=Reference,proto3" json:"Reference,omitempty"`
+	// incoming, outgoing or all (default).
+	Direction string `protobuf:"bytes,2,opt,name=Direction,proto3" json:"Direction,omitempty"`
+	// registered (default), sent, received or failed.
+	Status string `protobuf:"bytes,3,opt,name=Status,proto3" json:"Status,omitempty"`
+	// transfer, migration or release, any if empty.
+	Type string `protobuf:"bytes,4,opt,name=Type,proto3" json:"Type,omitempty"`
+	// Transactions after this one are returned.
+	Index string `protobuf:"bytes,5,opt,name=Index,proto3" json:"Index,omitempty"`
+	// reverse (default) or chronological.
+	Order string `protobuf:"bytes,6,opt,name=Order,proto3" json:"Order,omitempty"`
+	Limit int32  `protobuf:"varint,7,opt,name=Limit,proto3" json:"Limit,omitempty"`
+	// Unix timestamps resolved to pulses by their real dates, ignored if zero.
+	FromTimestamp int64 `protobuf:"varint,8,opt,name=FromTimestamp,proto3" json:"FromTimestamp,omitempty"`
+	ToTimestamp   int64 `protobuf:"varint,9,opt,name=ToTimestamp,proto3" json:"ToTimestamp,omitempty"`
+}
+
+func (m *ListMemberTransactionsRequest) Reset()      { *m = ListMemberTransactionsRequest{} }
+func (*ListMemberTransactionsRequest) ProtoMessage() {}
+func (*ListMemberTransactionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8117fb151b39a29f, []int{7}
+}
+func (m *ListMemberTransactionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListMemberTransactionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListMemberTransactionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListMemberTransactionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListMemberTransactionsRequest.Merge(m, src)
+}
+func (m *ListMemberTransactionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListMemberTransactionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListMemberTransactionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListMemberTransactionsRequest proto.InternalMessageInfo
+
+func (m *ListMemberTransactionsRequest) GetReference() string {
+	if m != nil {
+		return m.Reference
+	}
+	return ""
+}
+
+func (m *ListMemberTransactionsRequest) GetDirection() string {
+	if m != nil {
+		return m.Direction
+	}
+	return ""
+}
+
+func (m *ListMemberTransactionsRequest) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *ListMemberTransactionsRequest) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *ListMemberTransactionsRequest) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+func (m *ListMemberTransactionsRequest) GetOrder() string {
+	if m != nil {
+		return m.Order
+	}
+	return ""
+}
+
+func (m *ListMemberTransactionsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListMemberTransactionsRequest) GetFromTimestamp() int64 {
+	if m != nil {
+		return m.FromTimestamp
+	}
+	return 0
+}
+
+func (m *ListMemberTransactionsRequest) GetToTimestamp() int64 {
+	if m != nil {
+		return m.ToTimestamp
+	}
+	return 0
+}
+
+type GetTransactionRequest struct {
+	TxID string `protobuf:"bytes,1,opt,name=TxID,proto3" json:"TxID,omitempty"`
+}
+
+func (m *GetTransactionRequest) Reset()      { *m = GetTransactionRequest{} }
+func (*GetTransactionRequest) ProtoMessage() {}
+func (*GetTransactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8117fb151b39a29f, []int{8}
+}
+func (m *GetTransactionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetTransactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetTransactionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetTransactionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTransactionRequest.Merge(m, src)
+}
+func (m *GetTransactionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetTransactionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTransactionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTransactionRequest proto.InternalMessageInfo
+
+func (m *GetTransactionRequest) GetTxID() string {
+	if m != nil {
+		return m.TxID
+	}
+	return ""
+}
+
+type SearchTransactionsRequest struct {
+	// Transaction ID, member reference or pulse number.
+	Value         string `protobuf:"bytes,1,opt,name=Value,proto3" json:"Value,omitempty"`
+	Status        string `protobuf:"bytes,2,opt,name=Status,proto3" json:"Status,omitempty"`
+	Type          string `protobuf:"bytes,3,opt,name=Type,proto3" json:"Type,omitempty"`
+	Index         string `protobuf:"bytes,4,opt,name=Index,proto3" json:"Index,omitempty"`
+	Order         string `protobuf:"bytes,5,opt,name=Order,proto3" json:"Order,omitempty"`
+	Limit         int32  `protobuf:"varint,6,opt,name=Limit,proto3" json:"Limit,omitempty"`
+	FromTimestamp int64  `protobuf:"varint,7,opt,name=FromTimestamp,proto3" json:"FromTimestamp,omitempty"`
+	ToTimestamp   int64  `protobuf:"varint,8,opt,name=ToTimestamp,proto3" json:"ToTimestamp,omitempty"`
+}
+
+func (m *SearchTransactionsRequest) Reset()      { *m = SearchTransactionsRequest{} }
+func (*SearchTransactionsRequest) ProtoMessage() {}
+func (*SearchTransactionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8117fb151b39a29f, []int{9}
+}
+func (m *SearchTransactionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SearchTransactionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SearchTransactionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SearchTransactionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchTransactionsRequest.Merge(m, src)
+}
+func (m *SearchTransactionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SearchTransactionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchTransactionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchTransactionsRequest proto.InternalMessageInfo
+
+func (m *SearchTransactionsRequest) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *SearchTransactionsRequest) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *SearchTransactionsRequest) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *SearchTransactionsRequest) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+func (m *SearchTransactionsRequest) GetOrder() string {
+	if m != nil {
+		return m.Order
+	}
+	return ""
+}
+
+func (m *SearchTransactionsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *SearchTransactionsRequest) GetFromTimestamp() int64 {
+	if m != nil {
+		return m.FromTimestamp
+	}
+	return 0
+}
+
+func (m *SearchTransactionsRequest) GetToTimestamp() int64 {
+	if m != nil {
+		return m.ToTimestamp
+	}
+	return 0
+}
+
+type GetPulseRangeRequest struct {
+	FromTimestamp int64 `protobuf:"varint,1,opt,name=FromTimestamp,proto3" json:"FromTimestamp,omitempty"`
+	ToTimestamp   int64 `protobuf:"varint,2,opt,name=ToTimestamp,proto3" json:"ToTimestamp,omitempty"`
+	Limit         int32 `protobuf:"varint,3,opt,name=Limit,proto3" json:"Limit,omitempty"`
+	// Last known pulse number, the range starts from the next one.
+	PulseNumber int64 `protobuf:"varint,4,opt,name=PulseNumber,proto3" json:"PulseNumber,omitempty"`
+}
+
+func (m *GetPulseRangeRequest) Reset()      { *m = GetPulseRangeRequest{} }
+func (*GetPulseRangeRequest) ProtoMessage() {}
+func (*GetPulseRangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8117fb151b39a29f, []int{10}
+}
+func (m *GetPulseRangeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetPulseRangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetPulseRangeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetPulseRangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPulseRangeRequest.Merge(m, src)
+}
+func (m *GetPulseRangeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetPulseRangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPulseRangeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPulseRangeRequest proto.InternalMessageInfo
+
+func (m *GetPulseRangeRequest) GetFromTimestamp() int64 {
+	if m != nil {
+		return m.FromTimestamp
+	}
+	return 0
+}
+
+func (m *GetPulseRangeRequest) GetToTimestamp() int64 {
+	if m != nil {
+		return m.ToTimestamp
+	}
+	return 0
+}
+
+func (m *GetPulseRangeRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *GetPulseRangeRequest) GetPulseNumber() int64 {
+	if m != nil {
+		return m.PulseNumber
+	}
+	return 0
+}
+
+type PulseRange struct {
+	PulseNumbers []int64 `protobuf:"varint,1,rep,packed,name=PulseNumbers,proto3" json:"PulseNumbers,omitempty"`
+}
+
+func (m *PulseRange) Reset()      { *m = PulseRange{} }
+func (*PulseRange) ProtoMessage() {}
+func (*PulseRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8117fb151b39a29f, []int{11}
+}
+func (m *PulseRange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PulseRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PulseRange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PulseRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PulseRange.Merge(m, src)
+}
+func (m *PulseRange) XXX_Size() int {
+	return m.Size()
+}
+func (m *PulseRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_PulseRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PulseRange proto.InternalMessageInfo
+
+func (m *PulseRange) GetPulseNumbers() []int64 {
+	if m != nil {
+		return m.PulseNumbers
+	}
+	return nil
+}
+
+type WatchTransactionsRequest struct {
+	MemberReference string `protobuf:"bytes,1,opt,name=MemberReference,proto3" json:"MemberReference,omitempty"`
+	Type            string `protobuf:"bytes,2,opt,name=Type,proto3" json:"Type,omitempty"`
+	Status          string `protobuf:"bytes,3,opt,name=Status,proto3" json:"Status,omitempty"`
+	// Transactions changed after this index of a watched transaction are sent first.
+	Index string `protobuf:"bytes,4,opt,name=Index,proto3" json:"Index,omitempty"`
+}
+
+func (m *WatchTransactionsRequest) Reset()      { *m = WatchTransactionsRequest{} }
+func (*WatchTransactionsRequest) ProtoMessage() {}
+func (*WatchTransactionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8117fb151b39a29f, []int{12}
+}
+func (m *WatchTransactionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WatchTransactionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WatchTransactionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WatchTransactionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchTransactionsRequest.Merge(m, src)
+}
+func (m *WatchTransactionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *WatchTransactionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchTransactionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchTransactionsRequest proto.InternalMessageInfo
+
+func (m *WatchTransactionsRequest) GetMemberReference() string {
+	if m != nil {
+		return m.MemberReference
+	}
+	return ""
+}
+
+func (m *WatchTransactionsRequest) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *WatchTransactionsRequest) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *WatchTransactionsRequest) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*GetMemberRequest)(nil), "rpc.GetMemberRequest")
+	proto.RegisterType((*Member)(nil), "rpc.Member")
+	proto.RegisterType((*Deposit)(nil), "rpc.Deposit")
+	proto.RegisterType((*GetBalanceRequest)(nil), "rpc.GetBalanceRequest")
+	proto.RegisterType((*Balance)(nil), "rpc.Balance")
+	proto.RegisterType((*Transaction)(nil), "rpc.Transaction")
+	proto.RegisterType((*Transactions)(nil), "rpc.Transactions")
+	proto.RegisterType((*ListMemberTransactionsRequest)(nil), "rpc.ListMemberTransactionsRequest")
+	proto.RegisterType((*GetTransactionRequest)(nil), "rpc.GetTransactionRequest")
+	proto.RegisterType((*SearchTransactionsRequest)(nil), "rpc.SearchTransactionsRequest")
+	proto.RegisterType((*GetPulseRangeRequest)(nil), "rpc.GetPulseRangeRequest")
+	proto.RegisterType((*PulseRange)(nil), "rpc.PulseRange")
+	proto.RegisterType((*WatchTransactionsRequest)(nil), "rpc.WatchTransactionsRequest")
+}
+
+func init() {
+	proto.RegisterFile("internal/app/api/rpc/observer.proto", fileDescriptor_8117fb151b39a29f)
+}
+
+var fileDescriptor_8117fb151b39a29f = []byte{
+	// 978 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0xbd, 0x6e, 0xdc, 0x46,
+	0x10, 0x26, 0x8f, 0xf7, 0x3b, 0x27, 0xdb, 0xd2, 0xe4, 0x2c, 0xd0, 0x87, 0x98, 0x10, 0xd6, 0x41,
+	0x20, 0x24, 0x81, 0x24, 0x2b, 0x6e, 0x52, 0x04, 0x88, 0x84, 0xb3, 0x25, 0x21, 0xb6, 0xe5, 0xd0,
+	0x87, 0x18, 0x48, 0xb7, 0x77, 0xb7, 0xb1, 0x08, 0xf0, 0x48, 0x66, 0xc9, 0x33, 0xe4, 0x2e, 0x55,
+	0xea, 0x38, 0x45, 0x9e, 0x21, 0x8f, 0x92, 0x52, 0xa5, 0x8b, 0x14, 0xd1, 0xa9, 0x48, 0x4a, 0x3f,
+	0x42, 0xb0, 0xbb, 0xe4, 0xf1, 0x6f, 0xed, 0xa8, 0xe3, 0x7c, 0x33, 0x3b, 0xbb, 0xfc, 0xe6, 0x9b,
+	0xd9, 0x85, 0x7b, 0x5e, 0x90, 0x30, 0x1e, 0x50, 0x7f, 0x97, 0x46, 0xd1, 0x2e, 0x8d, 0xbc, 0x5d,
+	0x1e, 0x4d, 0x77, 0xc3, 0x49, 0xcc, 0xf8, 0x2b, 0xc6, 0x77, 0x22, 0x1e, 0x26, 0x21, 0x5a, 0x3c,
+	0x9a, 0x92, 0xa7, 0xb0, 0x7e, 0xc4, 0x92, 0x27, 0x6c, 0x3e, 0x61, 0xdc, 0x65, 0x3f, 0x2d, 0x58,
+	0x9c, 0xe0, 0xc7, 0xd0, 0x73, 0xd9, 0x8f, 0x8c, 0xb3, 0x60, 0xca, 0x6c, 0x73, 0xcb, 0xdc, 0xee,
+	0xb9, 0x39, 0x20, 0xbc, 0xcf, 0x16, 0x13, 0xdf, 0x9b, 0x7e, 0xcb, 0x5e, 0xdb, 0x0d, 0xe5, 0x5d,
+	0x01, 0xe4, 0xb7, 0x06, 0xb4, 0x55, 0xb6, 0xff, 0x49, 0xb3, 0x0d, 0xb7, 0x5e, 0x50, 0xdf, 0x67,
+	0x49, 0x1e, 0xa3, 0x92, 0x55, 0x61, 0xfc, 0x0c, 0xd6, 0x0f, 0xa6, 0xd3, 0x70, 0x11, 0x14, 0x42,
+	0x2d, 0x19, 0x5a, 0xc3, 0xd1, 0x86, 0xce, 0x21, 0xf5, 0xa9, 0x08, 0x69, 0xca, 0x90, 0xcc, 0x14,
+	0x59, 0x9e, 0x78, 0x2f, 0x39, 0x4d, 0xbc, 0x30, 0x38, 0x98, 0xcd, 0x38, 0x8b, 0x63, 0xbb, 0xa5,
+	0xb2, 0x54, 0x71, 0xfc, 0x04, 0x6e, 0x1c, 0x2e, 0x78, 0xc0, 0x66, 0x59, 0xae, 0xb6, 0x0c, 0x2c,
+	0x83, 0xb8, 0x0d, 0xdd, 0x11, 0x8b, 0xc2, 0xd8, 0x4b, 0x62, 0xbb, 0xb3, 0x65, 0x6d, 0xf7, 0xf7,
+	0xd7, 0x76, 0x78, 0x34, 0xdd, 0x49, 0x41, 0x77, 0xe5, 0x25, 0x6f, 0x2c, 0xe8, 0xa4, 0x06, 0x0e,
+	0xa0, 0x75, 0x12, 0xcc, 0xd8, 0xb9, 0x64, 0xc4, 0x72, 0x95, 0x21, 0x4e, 0x97, 0x2d, 0xab, 0xd0,
+	0x51, 0xc3, 0x05, 0x73, 0x59, 0xbd, 0xca, 0x74, 0x54, 0x61, 0x51, 0x81, 0x87, 0xc9, 0xd9, 0xf8,
+	0xfc, 0x98, 0xc6, 0x67, 0x29, 0x1f, 0x39, 0x80, 0x04, 0xd6, 0x0e, 0xe6, 0x82, 0xbe, 0xd3, 0xe0,
+	0x38, 0xf4, 0x67, 0x29, 0x1b, 0x25, 0x4c, 0xec, 0x75, 0xf0, 0x8a, 0x7a, 0x3e, 0x9d, 0xf8, 0x4c,
+	0x39, 0x52, 0x2e, 0xaa, 0x30, 0x7e, 0x0a, 0x37, 0x5d, 0xe6, 0x33, 0x1a, 0xb3, 0x59, 0x1a, 0xd8,
+	0x91, 0x81, 0x15, 0x54, 0x64, 0x14, 0x99, 0x53, 0x74, 0x44, 0x13, 0x66, 0x77, 0x25, 0x13, 0x55,
+	0xb8, 0x90, 0xf1, 0x61, 0x30, 0x93, 0x81, 0x3d, 0x19, 0x58, 0x41, 0x71, 0x13, 0xda, 0xcf, 0x13,
+	0x9a, 0x2c, 0x62, 0x1b, 0xe4, 0x8e, 0xa9, 0x25, 0xfe, 0x7e, 0xec, 0xcd, 0x59, 0x9c, 0xd0, 0x79,
+	0x64, 0xf7, 0xe5, 0xd2, 0x1c, 0x20, 0xf7, 0x61, 0xe3, 0x88, 0x25, 0x69, 0x2d, 0xaf, 0xa5, 0x7c,
+	0x72, 0x6f, 0x25, 0xae, 0xa2, 0xce, 0xcc, 0x92, 0xce, 0x44, 0xad, 0xfb, 0x63, 0x4e, 0x83, 0x98,
+	0x4e, 0x85, 0xa4, 0x10, 0xa1, 0x39, 0x3e, 0x3f, 0x19, 0xa5, 0x61, 0xf2, 0x3b, 0xd7, 0x80, 0x2a,
+	0xb1, 0x32, 0x64, 0xe4, 0xeb, 0x28, 0x2b, 0xa6, 0xfc, 0x2e, 0xfc, 0x5b, 0xb3, 0xf4, 0x6f, 0x9b,
+	0xd0, 0x4e, 0x59, 0x56, 0x55, 0x4b, 0x2d, 0x5c, 0x07, 0xeb, 0x11, 0xcb, 0xf4, 0x2a, 0x3e, 0x71,
+	0x0b, 0xfa, 0xcf, 0x16, 0x7e, 0xcc, 0x9e, 0x2e, 0x84, 0x36, 0x64, 0x51, 0x2c, 0xb7, 0x08, 0x95,
+	0x79, 0xea, 0x56, 0x78, 0xc2, 0x3d, 0xf8, 0xe8, 0x11, 0x0f, 0xe7, 0x55, 0xc5, 0xf5, 0xe4, 0x0e,
+	0x3a, 0x17, 0x7e, 0x01, 0x1b, 0xe3, 0xb0, 0x1a, 0xaf, 0x4a, 0x53, 0x77, 0xe0, 0x3e, 0x0c, 0x44,
+	0x92, 0x9a, 0xfa, 0xfb, 0x72, 0x81, 0xd6, 0x87, 0x3b, 0x80, 0xe3, 0xb0, 0xb6, 0x62, 0x4d, 0xae,
+	0xd0, 0x78, 0xc8, 0x08, 0xd6, 0x0a, 0x25, 0x89, 0xf1, 0x41, 0xd9, 0xb6, 0x4d, 0xd9, 0xbd, 0xeb,
+	0xb2, 0x7b, 0x0b, 0x0e, 0xb7, 0x14, 0x45, 0xde, 0x34, 0xe0, 0xee, 0x63, 0x2f, 0x4e, 0x87, 0x65,
+	0xd1, 0x75, 0xed, 0xc1, 0x39, 0xf2, 0x38, 0x93, 0x4b, 0xb2, 0xc1, 0xb9, 0x02, 0x0a, 0x95, 0xb6,
+	0x4a, 0x95, 0xce, 0x54, 0xd1, 0x2c, 0xa8, 0x62, 0xa5, 0x9f, 0x56, 0x51, 0x3f, 0x03, 0x68, 0x9d,
+	0xf2, 0x19, 0xe3, 0x69, 0xf5, 0x95, 0x21, 0xd0, 0xc7, 0xde, 0xdc, 0x53, 0xed, 0xd8, 0x72, 0x95,
+	0x21, 0x26, 0x9c, 0x60, 0xb6, 0x5a, 0xf7, 0x32, 0x28, 0xb4, 0x33, 0x0e, 0xf3, 0x18, 0xd5, 0x7e,
+	0x45, 0x88, 0x7c, 0x0e, 0xb7, 0x8f, 0x58, 0x52, 0xe4, 0x2c, 0xa5, 0x42, 0x23, 0x7b, 0xf2, 0x8f,
+	0x09, 0x77, 0x9e, 0x33, 0xca, 0xa7, 0x67, 0x3a, 0xf2, 0x06, 0xd0, 0xfa, 0x9e, 0xfa, 0x8b, 0x8c,
+	0x38, 0x65, 0x14, 0x68, 0x69, 0x68, 0x69, 0xb1, 0x74, 0xb4, 0x34, 0xb5, 0xb4, 0xb4, 0xb4, 0xb4,
+	0xb4, 0x3f, 0x48, 0x4b, 0xe7, 0x1a, 0xb4, 0x74, 0xeb, 0xb4, 0xfc, 0x6e, 0xc2, 0xe0, 0x88, 0x25,
+	0xb2, 0xcb, 0x5c, 0x1a, 0xbc, 0x5c, 0x0d, 0x98, 0xda, 0x06, 0xe6, 0x35, 0x36, 0x68, 0xd4, 0x36,
+	0xc8, 0x8f, 0x6f, 0x15, 0x8f, 0x5f, 0xe9, 0xf5, 0x66, 0xad, 0xd7, 0xc9, 0x1e, 0x40, 0x7e, 0x28,
+	0x71, 0x03, 0x14, 0x9c, 0xaa, 0x0f, 0x2c, 0xb7, 0x84, 0x91, 0x5f, 0x4c, 0xb0, 0x5f, 0xd0, 0x44,
+	0x5f, 0x33, 0xcd, 0x55, 0x64, 0xea, 0xaf, 0xa2, 0xac, 0x5e, 0x0d, 0xed, 0x70, 0x2b, 0x4b, 0x5e,
+	0x5b, 0xc7, 0xfd, 0xbf, 0x2c, 0xe8, 0x9e, 0xa6, 0x2f, 0x18, 0xbc, 0x0f, 0xbd, 0xd5, 0xb3, 0x05,
+	0x6f, 0xcb, 0xc6, 0xad, 0x3e, 0x63, 0x86, 0x7d, 0x09, 0x2b, 0x8c, 0x18, 0xf8, 0x00, 0x20, 0x1f,
+	0xf8, 0xb8, 0x99, 0xad, 0x29, 0xdf, 0x00, 0x43, 0x75, 0x85, 0x67, 0xc3, 0xdc, 0xc0, 0xef, 0x60,
+	0x53, 0xdf, 0xf3, 0x48, 0x64, 0xe4, 0x07, 0x07, 0xc2, 0x70, 0xa3, 0x3a, 0x52, 0x62, 0x62, 0xe0,
+	0x37, 0x70, 0xb3, 0xdc, 0x33, 0x38, 0xcc, 0x0e, 0x53, 0x6f, 0xa4, 0x61, 0x6d, 0x2a, 0x11, 0x03,
+	0x4f, 0x00, 0xeb, 0x7d, 0x84, 0x8e, 0x8c, 0x7c, 0x6f, 0x83, 0xe9, 0x0f, 0xf3, 0x35, 0xdc, 0x28,
+	0x09, 0x15, 0xef, 0x64, 0x67, 0xa9, 0x89, 0x77, 0x78, 0x4b, 0xba, 0x72, 0x9c, 0x18, 0x78, 0x0c,
+	0x1b, 0x35, 0x71, 0xe0, 0x5d, 0x19, 0xf7, 0x3e, 0xd1, 0xe8, 0xfe, 0x68, 0xcf, 0x3c, 0xfc, 0xea,
+	0xe2, 0xd2, 0x31, 0xde, 0x5e, 0x3a, 0xc6, 0xbb, 0x4b, 0xc7, 0xfc, 0x79, 0xe9, 0x98, 0x7f, 0x2c,
+	0x1d, 0xf3, 0xcf, 0xa5, 0x63, 0x5e, 0x2c, 0x1d, 0xf3, 0xef, 0xa5, 0x63, 0xfe, 0xbb, 0x74, 0x8c,
+	0x77, 0x4b, 0xc7, 0xfc, 0xf5, 0xca, 0x31, 0x2e, 0xae, 0x1c, 0xe3, 0xed, 0x95, 0x63, 0xfc, 0x20,
+	0xde, 0xb0, 0x93, 0xb6, 0x7c, 0xcf, 0x7e, 0xf9, 0xdf, 0x00, 0x93, 0x0f, 0x04, 0xae, 0xf6, 0x0a,
+	0x00, 0x00,
+}
+
+func (this *GetMemberRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetMemberRequest)
+	if !ok {
+		that2, ok := that.(GetMemberRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Reference != that1.Reference {
+		return false
+	}
+	if this.PublicKey != that1.PublicKey {
+		return false
+	}
+	return true
+}
+func (this *Member) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Member)
+	if !ok {
+		that2, ok := that.(Member)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Reference != that1.Reference {
+		return false
+	}
+	if this.WalletReference != that1.WalletReference {
+		return false
+	}
+	if this.AccountReference != that1.AccountReference {
+		return false
+	}
+	if this.Balance != that1.Balance {
+		return false
+	}
+	if this.MigrationAddress != that1.MigrationAddress {
+		return false
+	}
+	if this.BurnedBalance != that1.BurnedBalance {
+		return false
+	}
+	if len(this.Deposits) != len(that1.Deposits) {
+		return false
+	}
+	for i := range this.Deposits {
+		if !this.Deposits[i].Equal(that1.Deposits[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *Deposit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Deposit)
+	if !ok {
+		that2, ok := that.(Deposit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Index != that1.Index {
+		return false
+	}
+	if this.DepositReference != that1.DepositReference {
+		return false
+	}
+	if this.MemberReference != that1.MemberReference {
+		return false
+	}
+	if this.EthTxHash != that1.EthTxHash {
+		return false
+	}
+	if this.AmountOnHold != that1.AmountOnHold {
+		return false
+	}
+	if this.AvailableAmount != that1.AvailableAmount {
+		return false
+	}
+	if this.ReleasedAmount != that1.ReleasedAmount {
+		return false
+	}
+	if this.HoldReleaseDate != that1.HoldReleaseDate {
+		return false
+	}
+	if this.ReleaseEndDate != that1.ReleaseEndDate {
+		return false
+	}
+	if this.Status != that1.Status {
+		return false
+	}
+	if this.Timestamp != that1.Timestamp {
+		return false
+	}
+	return true
+}
+func (this *GetBalanceRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetBalanceRequest)
+	if !ok {
+		that2, ok := that.(GetBalanceRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Reference != that1.Reference {
+		return false
+	}
+	return true
+}
+func (this *Balance) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Balance)
+	if !ok {
+		that2, ok := that.(Balance)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Balance != that1.Balance {
+		return false
+	}
+	return true
+}
+func (this *Transaction) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Transaction)
+	if !ok {
+		that2, ok := that.(Transaction)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TxID != that1.TxID {
+		return false
+	}
+	if this.Index != that1.Index {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if this.Status != that1.Status {
+		return false
+	}
+	if this.Amount != that1.Amount {
+		return false
+	}
+	if this.Fee != that1.Fee {
+		return false
+	}
+	if this.PulseNumber != that1.PulseNumber {
+		return false
+	}
+	if this.Timestamp != that1.Timestamp {
+		return false
+	}
+	if this.FromMemberReference != that1.FromMemberReference {
+		return false
+	}
+	if this.ToMemberReference != that1.ToMemberReference {
+		return false
+	}
+	if this.FromDepositReference != that1.FromDepositReference {
+		return false
+	}
+	if this.ToDepositReference != that1.ToDepositReference {
+		return false
+	}
+	return true
+}
+func (this *Transactions) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Transactions)
+	if !ok {
+		that2, ok := that.(Transactions)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Transactions) != len(that1.Transactions) {
+		return false
+	}
+	for i := range this.Transactions {
+		if !this.Transactions[i].Equal(that1.Transactions[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ListMemberTransactionsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ListMemberTransactionsRequest)
+	if !ok {
+		that2, ok := that.(ListMemberTransactionsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Reference != that1.Reference {
+		return false
+	}
+	if this.Direction != that1.Direction {
+		return false
+	}
+	if this.Status != that1.Status {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if this.Index != that1.Index {
+		return false
+	}
+	if this.Order != that1.Order {
+		return false
+	}
+	if this.Limit != that1.Limit {
+		return false
+	}
+	if this.FromTimestamp != that1.FromTimestamp {
+		return false
+	}
+	if this.ToTimestamp != that1.ToTimestamp {
+		return false
+	}
+	return true
+}
+func (this *GetTransactionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetTransactionRequest)
+	if !ok {
+		that2, ok := that.(GetTransactionRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TxID != that1.TxID {
+		return false
+	}
+	return true
+}
+func (this *SearchTransactionsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SearchTransactionsRequest)
+	if !ok {
+		that2, ok := that.(SearchTransactionsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Value != that1.Value {
+		return false
+	}
+	if this.Status != that1.Status {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if this.Index != that1.Index {
+		return false
+	}
+	if this.Order != that1.Order {
+		return false
+	}
+	if this.Limit != that1.Limit {
+		return false
+	}
+	if this.FromTimestamp != that1.FromTimestamp {
+		return false
+	}
+	if this.ToTimestamp != that1.ToTimestamp {
+		return false
+	}
+	return true
+}
+func (this *GetPulseRangeRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetPulseRangeRequest)
+	if !ok {
+		that2, ok := that.(GetPulseRangeRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.FromTimestamp != that1.FromTimestamp {
+		return false
+	}
+	if this.ToTimestamp != that1.ToTimestamp {
+		return false
+	}
+	if this.Limit != that1.Limit {
+		return false
+	}
+	if this.PulseNumber != that1.PulseNumber {
+		return false
+	}
+	return true
+}
+func (this *PulseRange) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PulseRange)
+	if !ok {
+		that2, ok := that.(PulseRange)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.PulseNumbers) != len(that1.PulseNumbers) {
+		return false
+	}
+	for i := range this.PulseNumbers {
+		if this.PulseNumbers[i] != that1.PulseNumbers[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *WatchTransactionsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WatchTransactionsRequest)
+	if !ok {
+		that2, ok := that.(WatchTransactionsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MemberReference != that1.MemberReference {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if this.Status != that1.Status {
+		return false
+	}
+	if this.Index != that1.Index {
+		return false
+	}
+	return true
+}
+func (this *GetMemberRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&rpc.GetMemberRequest{")
+	s = append(s, "Reference: "+fmt.Sprintf("%#v", this.Reference)+",\n")
+	s = append(s, "PublicKey: "+fmt.Sprintf("%#v", this.PublicKey)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Member) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 11)
+	s = append(s, "&rpc.Member{")
+	s = append(s, "Reference: "+fmt.Sprintf("%#v", this.Reference)+",\n")
+	s = append(s, "WalletReference: "+fmt.Sprintf("%#v", this.WalletReference)+",\n")
+	s = append(s, "AccountReference: "+fmt.Sprintf("%#v", this.AccountReference)+",\n")
+	s = append(s, "Balance: "+fmt.Sprintf("%#v", this.Balance)+",\n")
+	s = append(s, "MigrationAddress: "+fmt.Sprintf("%#v", this.MigrationAddress)+",\n")
+	s = append(s, "BurnedBalance: "+fmt.Sprintf("%#v", this.BurnedBalance)+",\n")
+	if this.Deposits != nil {
+		s = append(s, "Deposits: "+fmt.Sprintf("%#v", this.Deposits)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Deposit) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 15)
+	s = append(s, "&rpc.Deposit{")
+	s = append(s, "Index: "+fmt.Sprintf("%#v", this.Index)+",\n")
+	s = append(s, "DepositReference: "+fmt.Sprintf("%#v", this.DepositReference)+",\n")
+	s = append(s, "MemberReference: "+fmt.Sprintf("%#v", this.MemberReference)+",\n")
+	s = append(s, "EthTxHash: "+fmt.Sprintf("%#v", this.EthTxHash)+",\n")
+	s = append(s, "AmountOnHold: "+fmt.Sprintf("%#v", this.AmountOnHold)+",\n")
+	s = append(s, "AvailableAmount: "+fmt.Sprintf("%#v", this.AvailableAmount)+",\n")
+	s = append(s, "ReleasedAmount: "+fmt.Sprintf("%#v", this.ReleasedAmount)+",\n")
+	s = append(s, "HoldReleaseDate: "+fmt.Sprintf("%#v", this.HoldReleaseDate)+",\n")
+	s = append(s, "ReleaseEndDate: "+fmt.Sprintf("%#v", this.ReleaseEndDate)+",\n")
+	s = append(s, "Status: "+fmt.Sprintf("%#v", this.Status)+",\n")
+	s = append(s, "Timestamp: "+fmt.Sprintf("%#v", this.Timestamp)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetBalanceRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&rpc.GetBalanceRequest{")
+	s = append(s, "Reference: "+fmt.Sprintf("%#v", this.Reference)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Balance) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&rpc.Balance{")
+	s = append(s, "Balance: "+fmt.Sprintf("%#v", this.Balance)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Transaction) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 16)
+	s = append(s, "&rpc.Transaction{")
+	s = append(s, "TxID: "+fmt.Sprintf("%#v", this.TxID)+",\n")
+	s = append(s, "Index: "+fmt.Sprintf("%#v", this.Index)+",\n")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "Status: "+fmt.Sprintf("%#v", this.Status)+",\n")
+	s = append(s, "Amount: "+fmt.Sprintf("%#v", this.Amount)+",\n")
+	s = append(s, "Fee: "+fmt.Sprintf("%#v", this.Fee)+",\n")
+	s = append(s, "PulseNumber: "+fmt.Sprintf("%#v", this.PulseNumber)+",\n")
+	s = append(s, "Timestamp: "+fmt.Sprintf("%#v", this.Timestamp)+",\n")
+	s = append(s, "FromMemberReference: "+fmt.Sprintf("%#v", this.FromMemberReference)+",\n")
+	s = append(s, "ToMemberReference: "+fmt.Sprintf("%#v", this.ToMemberReference)+",\n")
+	s = append(s, "FromDepositReference: "+fmt.Sprintf("%#v", this.FromDepositReference)+",\n")
+	s = append(s, "ToDepositReference: "+fmt.Sprintf("%#v", this.ToDepositReference)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Transactions) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&rpc.Transactions{")
+	if this.Transactions != nil {
+		s = append(s, "Transactions: "+fmt.Sprintf("%#v", this.Transactions)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ListMemberTransactionsRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 13)
+	s = append(s, "&rpc.ListMemberTransactionsRequest{")
+	s = append(s, "Reference: "+fmt.Sprintf("%#v", this.Reference)+",\n")
+	s = append(s, "Direction: "+fmt.Sprintf("%#v", this.Direction)+",\n")
+	s = append(s, "Status: "+fmt.Sprintf("%#v", this.Status)+",\n")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "Index: "+fmt.Sprintf("%#v", this.Index)+",\n")
+	s = append(s, "Order: "+fmt.Sprintf("%#v", this.Order)+",\n")
+	s = append(s, "Limit: "+fmt.Sprintf("%#v", this.Limit)+",\n")
+	s = append(s, "FromTimestamp: "+fmt.Sprintf("%#v", this.FromTimestamp)+",\n")
+	s = append(s, "ToTimestamp: "+fmt.Sprintf("%#v", this.ToTimestamp)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetTransactionRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&rpc.GetTransactionRequest{")
+	s = append(s, "TxID: "+fmt.Sprintf("%#v", this.TxID)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SearchTransactionsRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 12)
+	s = append(s, "&rpc.SearchTransactionsRequest{")
+	s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
+	s = append(s, "Status: "+fmt.Sprintf("%#v", this.Status)+",\n")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "Index: "+fmt.Sprintf("%#v", this.Index)+",\n")
+	s = append(s, "Order: "+fmt.Sprintf("%#v", this.Order)+",\n")
+	s = append(s, "Limit: "+fmt.Sprintf("%#v", this.Limit)+",\n")
+	s = append(s, "FromTimestamp: "+fmt.Sprintf("%#v", this.FromTimestamp)+",\n")
+	s = append(s, "ToTimestamp: "+fmt.Sprintf("%#v", this.ToTimestamp)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetPulseRangeRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&rpc.GetPulseRangeRequest{")
+	s = append(s, "FromTimestamp: "+fmt.Sprintf("%#v", this.FromTimestamp)+",\n")
+	s = append(s, "ToTimestamp: "+fmt.Sprintf("%#v", this.ToTimestamp)+",\n")
+	s = append(s, "Limit: "+fmt.Sprintf("%#v", this.Limit)+",\n")
+	s = append(s, "PulseNumber: "+fmt.Sprintf("%#v", this.PulseNumber)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PulseRange) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&rpc.PulseRange{")
+	s = append(s, "PulseNumbers: "+fmt.Sprintf("%#v", this.PulseNumbers)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *WatchTransactionsRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&rpc.WatchTransactionsRequest{")
+	s = append(s, "MemberReference: "+fmt.Sprintf("%#v", this.MemberReference)+",\n")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "Status: "+fmt.Sprintf("%#v", this.Status)+",\n")
+	s = append(s, "Index: "+fmt.Sprintf("%#v", this.Index)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringObserver(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ObserverClient is the client API for Observer service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ObserverClient interface {
+	// GetMember finds member by reference, migration address or public key.
+	GetMember(ctx context.Context, in *GetMemberRequest, opts ...grpc.CallOption) (*Member, error)
+	GetBalance(ctx context.Context, in *GetBalanceRequest, opts ...grpc.CallOption) (*Balance, error)
+	ListMemberTransactions(ctx context.Context, in *ListMemberTransactionsRequest, opts ...grpc.CallOption) (*Transactions, error)
+	GetTransaction(ctx context.Context, in *GetTransactionRequest, opts ...grpc.CallOption) (*Transaction, error)
+	SearchTransactions(ctx context.Context, in *SearchTransactionsRequest, opts ...grpc.CallOption) (*Transactions, error)
+	// GetPulseRange lists finalized pulse numbers between timestamps.
+	GetPulseRange(ctx context.Context, in *GetPulseRangeRequest, opts ...grpc.CallOption) (*PulseRange, error)
+	// WatchTransactions sends transactions when they are registered and when their status changes.
+	WatchTransactions(ctx context.Context, in *WatchTransactionsRequest, opts ...grpc.CallOption) (Observer_WatchTransactionsClient, error)
+}
+
+type observerClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewObserverClient(cc *grpc.ClientConn) ObserverClient {
+	return &observerClient{cc}
+}
+
+func (c *observerClient) GetMember(ctx context.Context, in *GetMemberRequest, opts ...grpc.CallOption) (*Member, error) {
+	out := new(Member)
+	err := c.cc.Invoke(ctx, "/rpc.Observer/GetMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *observerClient) GetBalance(ctx context.Context, in *GetBalanceRequest, opts ...grpc.CallOption) (*Balance, error) {
+	out := new(Balance)
+	err := c.cc.Invoke(ctx, "/rpc.Observer/GetBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *observerClient) ListMemberTransactions(ctx context.Context, in *ListMemberTransactionsRequest, opts ...grpc.CallOption) (*Transactions, error) {
+	out := new(Transactions)
+	err := c.cc.Invoke(ctx, "/rpc.Observer/ListMemberTransactions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *observerClient) GetTransaction(ctx context.Context, in *GetTransactionRequest, opts ...grpc.CallOption) (*Transaction, error) {
+	out := new(Transaction)
+	err := c.cc.Invoke(ctx, "/rpc.Observer/GetTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *observerClient) SearchTransactions(ctx context.Context, in *SearchTransactionsRequest, opts ...grpc.CallOption) (*Transactions, error) {
+	out := new(Transactions)
+	err := c.cc.Invoke(ctx, "/rpc.Observer/SearchTransactions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *observerClient) GetPulseRange(ctx context.Context, in *GetPulseRangeRequest, opts ...grpc.CallOption) (*PulseRange, error) {
+	out := new(PulseRange)
+	err := c.cc.Invoke(ctx, "/rpc.Observer/GetPulseRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *observerClient) WatchTransactions(ctx context.Context, in *WatchTransactionsRequest, opts ...grpc.CallOption) (Observer_WatchTransactionsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Observer_serviceDesc.Streams[0], "/rpc.Observer/WatchTransactions", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &observerWatchTransactionsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Observer_WatchTransactionsClient interface {
+	Recv() (*Transaction, error)
+	grpc.ClientStream
+}
+
+type observerWatchTransactionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *observerWatchTransactionsClient) Recv() (*Transaction, error) {
+	m := new(Transaction)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ObserverServer is the server API for Observer service.
+type ObserverServer interface {
+	// GetMember finds member by reference, migration address or public key.
+	GetMember(context.Context, *GetMemberRequest) (*Member, error)
+	GetBalance(context.Context, *GetBalanceRequest) (*Balance, error)
+	ListMemberTransactions(context.Context, *ListMemberTransactionsRequest) (*Transactions, error)
+	GetTransaction(context.Context, *GetTransactionRequest) (*Transaction, error)
+	SearchTransactions(context.Context, *SearchTransactionsRequest) (*Transactions, error)
+	// GetPulseRange lists finalized pulse numbers between timestamps.
+	GetPulseRange(context.Context, *GetPulseRangeRequest) (*PulseRange, error)
+	// WatchTransactions sends transactions when they are registered and when their status changes.
+	WatchTransactions(*WatchTransactionsRequest, Observer_WatchTransactionsServer) error
+}
+
+// UnimplementedObserverServer can be embedded to have forward compatible implementations.
+type UnimplementedObserverServer struct {
+}
+
+func (*UnimplementedObserverServer) GetMember(ctx context.Context, req *GetMemberRequest) (*Member, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMember not implemented")
+}
+func (*UnimplementedObserverServer) GetBalance(ctx context.Context, req *GetBalanceRequest) (*Balance, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBalance not implemented")
+}
+func (*UnimplementedObserverServer) ListMemberTransactions(ctx context.Context, req *ListMemberTransactionsRequest) (*Transactions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMemberTransactions not implemented")
+}
+func (*UnimplementedObserverServer) GetTransaction(ctx context.Context, req *GetTransactionRequest) (*Transaction, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransaction not implemented")
+}
+func (*UnimplementedObserverServer) SearchTransactions(ctx context.Context, req *SearchTransactionsRequest) (*Transactions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchTransactions not implemented")
+}
+func (*UnimplementedObserverServer) GetPulseRange(ctx context.Context, req *GetPulseRangeRequest) (*PulseRange, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPulseRange not implemented")
+}
+func (*UnimplementedObserverServer) WatchTransactions(req *WatchTransactionsRequest, srv Observer_WatchTransactionsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchTransactions not implemented")
+}
+
+func RegisterObserverServer(s *grpc.Server, srv ObserverServer) {
+	s.RegisterService(&_Observer_serviceDesc, srv)
+}
+
+func _Observer_GetMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ObserverServer).GetMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.Observer/GetMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObserverServer).GetMember(ctx, req.(*GetMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Observer_GetBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ObserverServer).GetBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.Observer/GetBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObserverServer).GetBalance(ctx, req.(*GetBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Observer_ListMemberTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMemberTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ObserverServer).ListMemberTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.Observer/ListMemberTransactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObserverServer).ListMemberTransactions(ctx, req.(*ListMemberTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Observer_GetTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ObserverServer).GetTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.Observer/GetTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObserverServer).GetTransaction(ctx, req.(*GetTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Observer_SearchTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ObserverServer).SearchTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.Observer/SearchTransactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObserverServer).SearchTransactions(ctx, req.(*SearchTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Observer_GetPulseRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPulseRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ObserverServer).GetPulseRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.Observer/GetPulseRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObserverServer).GetPulseRange(ctx, req.(*GetPulseRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Observer_WatchTransactions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchTransactionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ObserverServer).WatchTransactions(m, &observerWatchTransactionsServer{stream})
+}
+
+type Observer_WatchTransactionsServer interface {
+	Send(*Transaction) error
+	grpc.ServerStream
+}
+
+type observerWatchTransactionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *observerWatchTransactionsServer) Send(m *Transaction) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _Observer_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "rpc.Observer",
+	HandlerType: (*ObserverServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetMember",
+			Handler:    _Observer_GetMember_Handler,
+		},
+		{
+			MethodName: "GetBalance",
+			Handler:    _Observer_GetBalance_Handler,
+		},
+		{
+			MethodName: "ListMemberTransactions",
+			Handler:    _Observer_ListMemberTransactions_Handler,
+		},
+		{
+			MethodName: "GetTransaction",
+			Handler:    _Observer_GetTransaction_Handler,
+		},
+		{
+			MethodName: "SearchTransactions",
+			Handler:    _Observer_SearchTransactions_Handler,
+		},
+		{
+			MethodName: "GetPulseRange",
+			Handler:    _Observer_GetPulseRange_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchTransactions",
+			Handler:       _Observer_WatchTransactions_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "internal/app/api/rpc/observer.proto",
+}
+
+func (m *GetMemberRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetMemberRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetMemberRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintObserver(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Reference) > 0 {
+		i -= len(m.Reference)
+		copy(dAtA[i:], m.Reference)
+		i = encodeVarintObserver(dAtA, i, uint64(len(m.Reference)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Member) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Member) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Member) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deposits) > 0 {
+		for iNdEx := len(m.Deposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintObserver(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.BurnedBalance) > 0 {
+		i -= len(m.BurnedBalance)
+		copy(dAtA[i:], m.BurnedBalance)
+		i = encodeVarintObserver(dAtA, i, uint64(len(m.BurnedBalance)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.MigrationAddress) > 0 {
+		i -= len(m.MigrationAddress)
+		copy(dAtA[i:], m.MigrationAddress)
+		i = encodeVarintObserver(dAtA, i, uint64(len(m.MigrationAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Balance) > 0 {
+		i -= len(m.Balance)
+		copy(dAtA[i:], m.Balance)
+		i = encodeVarintObserver(dAtA, i, uint64(len(m.Balance)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.AccountReference) > 0 {
+		i -= len(m.AccountReference)
+		copy(dAtA[i:], m.AccountReference)
+		i = encodeVarintObserver(dAtA, i, uint64(len(m.AccountReference)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.WalletReference) > 0 {
+		i -= len(m.WalletReference)
+		copy(dAtA[i:], m.WalletReference)
+		i = encodeVarintObserver(dAtA, i, uint64(len(m.WalletReference)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Reference) > 0 {
+		i -= len(m.Reference)
+		copy(dAtA[i:], m.Reference)
+		i = encodeVarintObserver(dAtA, i, uint64(len(m.Reference)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Deposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Deposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Deposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		i = encodeVarintObserver(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintObserver(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.ReleaseEndDate != 0 {
+		i = encodeVarintObserver(dAtA, i, uint64(m.ReleaseEndDate))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.HoldReleaseDate != 0 {
+		i = encodeVarintObserver(dAtA, i, uint64(m.HoldReleaseDate))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.ReleasedAmount) > 0 {
+		i -= len(m.ReleasedAmount)
+		copy(dAtA[i:], m.ReleasedAmount)
+		i = encodeVarintObserver(dAtA, i, uint64(len(m.ReleasedAmount)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.AvailableAmount) > 0 {
+		i -= len(m.AvailableAmount)
+		copy(dAtA[i:], m.AvailableAmount)
+		i = encodeVarintObserver(dAtA, i, uint64(len(m.AvailableAmount)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.AmountOnHold) > 0 {
+		i -= len(m.AmountOnHold)
+		copy(dAtA[i:], m.AmountOnHold)
+		i = encodeVarintObserver(dAtA, i, uint64(len(m.AmountOnHold)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.EthTxHash) > 0 {
+		i -= len(m.EthTxHash)
+		copy(dAtA[i:], m.EthTxHash)
+		i = encodeVarintObserver(dAtA, i, uint64(len(m.EthTxHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MemberReference) > 0 {
+		i -= len(m.MemberReference)
+		copy(dAtA[i:], m.MemberReference)
+		i = encodeVarintObserver(dAtA, i, uint64(len(m.MemberReference)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DepositReference) > 0 {
+		i -= len(m.DepositReference)
+		copy(dAtA[i:], m.DepositReference)
+		i = encodeVarintObserver(dAtA, i, uint64(len(m.DepositReference)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintObserver(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetBalanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reference) > 0 {
+		i -= len(m.Reference)
+		copy(dAtA[i:], m.Reference)
+		i = encodeVarintObserver(dAtA, i, uint64(len(m.Reference)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Balance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Balance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Balance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Balance) > 0 {
+		i -= len(m.Balance)
+		copy(dAtA[i:], m.Balance)
+		i = encodeVarintObserver(dAtA, i, uint64(len(m.Balance)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Transaction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Transaction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Transaction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ToDepositReference) > 0 {
+		i -= len(m.ToDepositReference)
+		copy(dAtA[i:], m.ToDepositReference)
+		i = encodeVarintObserver(dAtA, i, uint64(len(m.ToDepositReference)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.FromDepositReference) > 0 {
+		i -= len(m.FromDepositReference)
+		copy(dAtA[i:], m.FromDepositReference)
+		i = encodeVarintObserver(dAtA, i, uint64(len(m.FromDepositReference)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.ToMemberReference) > 0 {
+		i -= len(m.ToMemberReference)
+		copy(dAtA[i:], m.ToMemberReference)
+		i = encodeVarintObserver(dAtA, i, uint64(len(m.ToMemberReference)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.FromMemberReference) > 0 {
+		i -= len(m.FromMemberReference)
+		copy(dAtA[i:], m.FromMemberReference)
+		i = encodeVarintObserver(dAtA, i, uint64(len(m.FromMemberReference)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintObserver(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.PulseNumber != 0 {
+		i = encodeVarintObserver(dAtA, i, uint64(m.PulseNumber))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Fee) > 0 {
+		i -= len(m.Fee)
+		copy(dAtA[i:], m.Fee)
+		i = encodeVarintObserver(dAtA, i, uint64(len(m.Fee)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintObserver(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintObserver(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintObserver(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintObserver(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TxID) > 0 {
+		i -= len(m.TxID)
+		copy(dAtA[i:], m.TxID)
+		i = encodeVarintObserver(dAtA, i, uint64(len(m.TxID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Transactions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Transactions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Transactions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Transactions) > 0 {
+		for iNdEx := len(m.Transactions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Transactions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintObserver(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListMemberTransactionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListMemberTransactionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListMemberTransactionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ToTimestamp != 0 {
+		i = encodeVarintObserver(dAtA, i, uint64(m.ToTimestamp))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.FromTimestamp != 0 {
+		i = encodeVarintObserver(dAtA, i, uint64(m.FromTimestamp))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Limit != 0 {
+		i = encodeVarintObserver(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Order) > 0 {
+		i -= len(m.Order)
+		copy(dAtA[i:], m.Order)
+		i = encodeVarintObserver(dAtA, i, uint64(len(m.Order)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintObserver(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintObserver(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintObserver(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Direction) > 0 {
+		i -= len(m.Direction)
+		copy(dAtA[i:], m.Direction)
+		i = encodeVarintObserver(dAtA, i, uint64(len(m.Direction)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Reference) > 0 {
+		i -= len(m.Reference)
+		copy(dAtA[i:], m.Reference)
+		i = encodeVarintObserver(dAtA, i, uint64(len(m.Reference)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTransactionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTransactionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTransactionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxID) > 0 {
+		i -= len(m.TxID)
+		copy(dAtA[i:], m.TxID)
+		i = encodeVarintObserver(dAtA, i, uint64(len(m.TxID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SearchTransactionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SearchTransactionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SearchTransactionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ToTimestamp != 0 {
+		i = encodeVarintObserver(dAtA, i, uint64(m.ToTimestamp))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.FromTimestamp != 0 {
+		i = encodeVarintObserver(dAtA, i, uint64(m.FromTimestamp))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Limit != 0 {
+		i = encodeVarintObserver(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Order) > 0 {
+		i -= len(m.Order)
+		copy(dAtA[i:], m.Order)
+		i = encodeVarintObserver(dAtA, i, uint64(len(m.Order)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintObserver(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintObserver(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintObserver(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintObserver(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetPulseRangeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetPulseRangeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetPulseRangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PulseNumber != 0 {
+		i = encodeVarintObserver(dAtA, i, uint64(m.PulseNumber))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Limit != 0 {
+		i = encodeVarintObserver(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ToTimestamp != 0 {
+		i = encodeVarintObserver(dAtA, i, uint64(m.ToTimestamp))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromTimestamp != 0 {
+		i = encodeVarintObserver(dAtA, i, uint64(m.FromTimestamp))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PulseRange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PulseRange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PulseRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PulseNumbers) > 0 {
+		dAtA2 := make([]byte, len(m.PulseNumbers)*10)
+		var j1 int
+		for _, num1 := range m.PulseNumbers {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintObserver(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchTransactionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchTransactionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchTransactionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintObserver(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintObserver(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintObserver(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MemberReference) > 0 {
+		i -= len(m.MemberReference)
+		copy(dAtA[i:], m.MemberReference)
+		i = encodeVarintObserver(dAtA, i, uint64(len(m.MemberReference)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintObserver(dAtA []byte, offset int, v uint64) int {
+	offset -= sovObserver(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GetMemberRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Reference)
+	if l > 0 {
+		n += 1 + l + sovObserver(uint64(l))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovObserver(uint64(l))
+	}
+	return n
+}
+
+func (m *Member) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Reference)
+	if l > 0 {
+		n += 1 + l + sovObserver(uint64(l))
+	}
+	l = len(m.WalletReference)
+	if l > 0 {
+		n += 1 + l + sovObserver(uint64(l))
+	}
+	l = len(m.AccountReference)
+	if l > 0 {
+		n += 1 + l + sovObserver(uint64(l))
+	}
+	l = len(m.Balance)
+	if l > 0 {
+		n += 1 + l + sovObserver(uint64(l))
+	}
+	l = len(m.MigrationAddress)
+	if l > 0 {
+		n += 1 + l + sovObserver(uint64(l))
+	}
+	l = len(m.BurnedBalance)
+	if l > 0 {
+		n += 1 + l + sovObserver(uint64(l))
+	}
+	if len(m.Deposits) > 0 {
+		for _, e := range m.Deposits {
+			l = e.Size()
+			n += 1 + l + sovObserver(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Deposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovObserver(uint64(m.Index))
+	}
+	l = len(m.DepositReference)
+	if l > 0 {
+		n += 1 + l + sovObserver(uint64(l))
+	}
+	l = len(m.MemberReference)
+	if l > 0 {
+		n += 1 + l + sovObserver(uint64(l))
+	}
+	l = len(m.EthTxHash)
+	if l > 0 {
+		n += 1 + l + sovObserver(uint64(l))
+	}
+	l = len(m.AmountOnHold)
+	if l > 0 {
+		n += 1 + l + sovObserver(uint64(l))
+	}
+	l = len(m.AvailableAmount)
+	if l > 0 {
+		n += 1 + l + sovObserver(uint64(l))
+	}
+	l = len(m.ReleasedAmount)
+	if l > 0 {
+		n += 1 + l + sovObserver(uint64(l))
+	}
+	if m.HoldReleaseDate != 0 {
+		n += 1 + sovObserver(uint64(m.HoldReleaseDate))
+	}
+	if m.ReleaseEndDate != 0 {
+		n += 1 + sovObserver(uint64(m.ReleaseEndDate))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovObserver(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovObserver(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func (m *GetBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Reference)
+	if l > 0 {
+		n += 1 + l + sovObserver(uint64(l))
+	}
+	return n
+}
+
+func (m *Balance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Balance)
+	if l > 0 {
+		n += 1 + l + sovObserver(uint64(l))
+	}
+	return n
+}
+
+func (m *Transaction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxID)
+	if l > 0 {
+		n += 1 + l + sovObserver(uint64(l))
+	}
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovObserver(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovObserver(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovObserver(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovObserver(uint64(l))
+	}
+	l = len(m.Fee)
+	if l > 0 {
+		n += 1 + l + sovObserver(uint64(l))
+	}
+	if m.PulseNumber != 0 {
+		n += 1 + sovObserver(uint64(m.PulseNumber))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovObserver(uint64(m.Timestamp))
+	}
+	l = len(m.FromMemberReference)
+	if l > 0 {
+		n += 1 + l + sovObserver(uint64(l))
+	}
+	l = len(m.ToMemberReference)
+	if l > 0 {
+		n += 1 + l + sovObserver(uint64(l))
+	}
+	l = len(m.FromDepositReference)
+	if l > 0 {
+		n += 1 + l + sovObserver(uint64(l))
+	}
+	l = len(m.ToDepositReference)
+	if l > 0 {
+		n += 1 + l + sovObserver(uint64(l))
+	}
+	return n
+}
+
+func (m *Transactions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Transactions) > 0 {
+		for _, e := range m.Transactions {
+			l = e.Size()
+			n += 1 + l + sovObserver(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ListMemberTransactionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Reference)
+	if l > 0 {
+		n += 1 + l + sovObserver(uint64(l))
+	}
+	l = len(m.Direction)
+	if l > 0 {
+		n += 1 + l + sovObserver(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovObserver(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovObserver(uint64(l))
+	}
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovObserver(uint64(l))
+	}
+	l = len(m.Order)
+	if l > 0 {
+		n += 1 + l + sovObserver(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovObserver(uint64(m.Limit))
+	}
+	if m.FromTimestamp != 0 {
+		n += 1 + sovObserver(uint64(m.FromTimestamp))
+	}
+	if m.ToTimestamp != 0 {
+		n += 1 + sovObserver(uint64(m.ToTimestamp))
+	}
+	return n
+}
+
+func (m *GetTransactionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxID)
+	if l > 0 {
+		n += 1 + l + sovObserver(uint64(l))
+	}
+	return n
+}
+
+func (m *SearchTransactionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovObserver(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovObserver(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovObserver(uint64(l))
+	}
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovObserver(uint64(l))
+	}
+	l = len(m.Order)
+	if l > 0 {
+		n += 1 + l + sovObserver(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovObserver(uint64(m.Limit))
+	}
+	if m.FromTimestamp != 0 {
+		n += 1 + sovObserver(uint64(m.FromTimestamp))
+	}
+	if m.ToTimestamp != 0 {
+		n += 1 + sovObserver(uint64(m.ToTimestamp))
+	}
+	return n
+}
+
+func (m *GetPulseRangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromTimestamp != 0 {
+		n += 1 + sovObserver(uint64(m.FromTimestamp))
+	}
+	if m.ToTimestamp != 0 {
+		n += 1 + sovObserver(uint64(m.ToTimestamp))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovObserver(uint64(m.Limit))
+	}
+	if m.PulseNumber != 0 {
+		n += 1 + sovObserver(uint64(m.PulseNumber))
+	}
+	return n
+}
+
+func (m *PulseRange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PulseNumbers) > 0 {
+		l = 0
+		for _, e := range m.PulseNumbers {
+			l += sovObserver(uint64(e))
+		}
+		n += 1 + sovObserver(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *WatchTransactionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MemberReference)
+	if l > 0 {
+		n += 1 + l + sovObserver(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovObserver(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovObserver(uint64(l))
+	}
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovObserver(uint64(l))
+	}
+	return n
+}
+
+func sovObserver(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozObserver(x uint64) (n int) {
+	return sovObserver(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *GetMemberRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetMemberRequest{`,
+		`Reference:` + fmt.Sprintf("%v", this.Reference) + `,`,
+		`PublicKey:` + fmt.Sprintf("%v", this.PublicKey) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Member) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForDeposits := "[]*Deposit{"
+	for _, f := range this.Deposits {
+		repeatedStringForDeposits += strings.Replace(f.String(), "Deposit", "Deposit", 1) + ","
+	}
+	repeatedStringForDeposits += "}"
+	s := strings.Join([]string{`&Member{`,
+		`Reference:` + fmt.Sprintf("%v", this.Reference) + `,`,
+		`WalletReference:` + fmt.Sprintf("%v", this.WalletReference) + `,`,
+		`AccountReference:` + fmt.Sprintf("%v", this.AccountReference) + `,`,
+		`Balance:` + fmt.Sprintf("%v", this.Balance) + `,`,
+		`MigrationAddress:` + fmt.Sprintf("%v", this.MigrationAddress) + `,`,
+		`BurnedBalance:` + fmt.Sprintf("%v", this.BurnedBalance) + `,`,
+		`Deposits:` + repeatedStringForDeposits + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Deposit) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Deposit{`,
+		`Index:` + fmt.Sprintf("%v", this.Index) + `,`,
+		`DepositReference:` + fmt.Sprintf("%v", this.DepositReference) + `,`,
+		`MemberReference:` + fmt.Sprintf("%v", this.MemberReference) + `,`,
+		`EthTxHash:` + fmt.Sprintf("%v", this.EthTxHash) + `,`,
+		`AmountOnHold:` + fmt.Sprintf("%v", this.AmountOnHold) + `,`,
+		`AvailableAmount:` + fmt.Sprintf("%v", this.AvailableAmount) + `,`,
+		`ReleasedAmount:` + fmt.Sprintf("%v", this.ReleasedAmount) + `,`,
+		`HoldReleaseDate:` + fmt.Sprintf("%v", this.HoldReleaseDate) + `,`,
+		`ReleaseEndDate:` + fmt.Sprintf("%v", this.ReleaseEndDate) + `,`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetBalanceRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetBalanceRequest{`,
+		`Reference:` + fmt.Sprintf("%v", this.Reference) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Balance) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Balance{`,
+		`Balance:` + fmt.Sprintf("%v", this.Balance) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Transaction) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Transaction{`,
+		`TxID:` + fmt.Sprintf("%v", this.TxID) + `,`,
+		`Index:` + fmt.Sprintf("%v", this.Index) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`Amount:` + fmt.Sprintf("%v", this.Amount) + `,`,
+		`Fee:` + fmt.Sprintf("%v", this.Fee) + `,`,
+		`PulseNumber:` + fmt.Sprintf("%v", this.PulseNumber) + `,`,
+		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
+		`FromMemberReference:` + fmt.Sprintf("%v", this.FromMemberReference) + `,`,
+		`ToMemberReference:` + fmt.Sprintf("%v", this.ToMemberReference) + `,`,
+		`FromDepositReference:` + fmt.Sprintf("%v", this.FromDepositReference) + `,`,
+		`ToDepositReference:` + fmt.Sprintf("%v", this.ToDepositReference) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Transactions) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForTransactions := "[]*Transaction{"
+	for _, f := range this.Transactions {
+		repeatedStringForTransactions += strings.Replace(f.String(), "Transaction", "Transaction", 1) + ","
+	}
+	repeatedStringForTransactions += "}"
+	s := strings.Join([]string{`&Transactions{`,
+		`Transactions:` + repeatedStringForTransactions + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListMemberTransactionsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListMemberTransactionsRequest{`,
+		`Reference:` + fmt.Sprintf("%v", this.Reference) + `,`,
+		`Direction:` + fmt.Sprintf("%v", this.Direction) + `,`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Index:` + fmt.Sprintf("%v", this.Index) + `,`,
+		`Order:` + fmt.Sprintf("%v", this.Order) + `,`,
+		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
+		`FromTimestamp:` + fmt.Sprintf("%v", this.FromTimestamp) + `,`,
+		`ToTimestamp:` + fmt.Sprintf("%v", this.ToTimestamp) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetTransactionRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetTransactionRequest{`,
+		`TxID:` + fmt.Sprintf("%v", this.TxID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SearchTransactionsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SearchTransactionsRequest{`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Index:` + fmt.Sprintf("%v", this.Index) + `,`,
+		`Order:` + fmt.Sprintf("%v", this.Order) + `,`,
+		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
+		`FromTimestamp:` + fmt.Sprintf("%v", this.FromTimestamp) + `,`,
+		`ToTimestamp:` + fmt.Sprintf("%v", this.ToTimestamp) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetPulseRangeRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetPulseRangeRequest{`,
+		`FromTimestamp:` + fmt.Sprintf("%v", this.FromTimestamp) + `,`,
+		`ToTimestamp:` + fmt.Sprintf("%v", this.ToTimestamp) + `,`,
+		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
+		`PulseNumber:` + fmt.Sprintf("%v", this.PulseNumber) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PulseRange) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PulseRange{`,
+		`PulseNumbers:` + fmt.Sprintf("%v", this.PulseNumbers) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WatchTransactionsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WatchTransactionsRequest{`,
+		`MemberReference:` + fmt.Sprintf("%v", this.MemberReference) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`Index:` + fmt.Sprintf("%v", this.Index) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringObserver(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *GetMemberRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowObserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetMemberRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetMemberRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipObserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Member) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowObserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Member: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Member: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletReference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WalletReference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountReference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountReference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrationAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MigrationAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnedBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BurnedBalance = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthObserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposits = append(m.Deposits, &Deposit{})
+			if err := m.Deposits[len(m.Deposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipObserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Deposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowObserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Deposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Deposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositReference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositReference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberReference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MemberReference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountOnHold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AmountOnHold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvailableAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AvailableAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleasedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReleasedAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HoldReleaseDate", wireType)
+			}
+			m.HoldReleaseDate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HoldReleaseDate |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseEndDate", wireType)
+			}
+			m.ReleaseEndDate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReleaseEndDate |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipObserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetBalanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowObserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetBalanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipObserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Balance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowObserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Balance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Balance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipObserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Transaction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowObserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Transaction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Transaction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PulseNumber", wireType)
+			}
+			m.PulseNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PulseNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromMemberReference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromMemberReference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToMemberReference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToMemberReference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromDepositReference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromDepositReference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToDepositReference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToDepositReference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipObserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Transactions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowObserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Transactions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Transactions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transactions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthObserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transactions = append(m.Transactions, &Transaction{})
+			if err := m.Transactions[len(m.Transactions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipObserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListMemberTransactionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowObserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListMemberTransactionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListMemberTransactionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Direction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Order = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromTimestamp", wireType)
+			}
+			m.FromTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToTimestamp", wireType)
+			}
+			m.ToTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipObserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetTransactionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowObserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetTransactionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetTransactionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipObserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SearchTransactionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowObserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SearchTransactionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SearchTransactionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Order = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromTimestamp", wireType)
+			}
+			m.FromTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToTimestamp", wireType)
+			}
+			m.ToTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipObserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetPulseRangeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowObserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetPulseRangeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetPulseRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromTimestamp", wireType)
+			}
+			m.FromTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToTimestamp", wireType)
+			}
+			m.ToTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PulseNumber", wireType)
+			}
+			m.PulseNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PulseNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipObserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PulseRange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowObserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PulseRange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PulseRange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowObserver
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PulseNumbers = append(m.PulseNumbers, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowObserver
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthObserver
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthObserver
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PulseNumbers) == 0 {
+					m.PulseNumbers = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowObserver
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PulseNumbers = append(m.PulseNumbers, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PulseNumbers", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipObserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WatchTransactionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowObserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WatchTransactionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WatchTransactionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberReference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MemberReference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipObserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipObserver(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowObserver
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowObserver
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowObserver
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthObserver
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupObserver
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthObserver
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthObserver        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowObserver          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupObserver = fmt.Errorf("proto: unexpected end of group")
+)
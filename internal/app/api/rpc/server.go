@@ -0,0 +1,336 @@
+// Package rpc serves the observer API over gRPC, observer.pb.go is generated by `make proto`.
+package rpc
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/go-pg/pg/orm"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/logicrunner/builtin/foundation"
+	"github.com/insolar/mainnet/application/appfoundation"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/insolar/observer/component"
+	"github.com/insolar/observer/internal/app/api"
+	"github.com/insolar/observer/internal/app/observer"
+	"github.com/insolar/observer/internal/dbconn"
+	"github.com/insolar/observer/internal/models"
+)
+
+const maxLimit = 1000
+
+var errInternal = status.Error(codes.Internal, "Internal error.")
+
+// Server answers like the REST handlers, NotFound is returned where they respond with 204.
+type Server struct {
+	db       *dbconn.Pool
+	log      insolar.Logger
+	pStorage observer.PulseStorage
+	stream   *api.Stream
+}
+
+func NewServer(db *dbconn.Pool, log insolar.Logger, pStorage observer.PulseStorage, stream *api.Stream) *Server {
+	return &Server{db: db, log: log, pStorage: pStorage, stream: stream}
+}
+
+func (s *Server) GetMember(ctx context.Context, req *GetMemberRequest) (*Member, error) {
+	var member *models.Member
+	var err error
+	byReference := false
+	switch {
+	case req.PublicKey != "":
+		publicKey, pkErr := foundation.ExtractCanonicalPublicKey(req.PublicKey)
+		if pkErr != nil {
+			return nil, status.Error(codes.InvalidArgument, "PublicKey is invalid.")
+		}
+		member, err = component.GetMemberByPublicKey(ctx, s.db.Read(), publicKey)
+	case appfoundation.IsEthereumAddress(req.Reference):
+		member, err = component.GetMemberByMigrationAddress(ctx, s.db.Read(), req.Reference)
+	default:
+		ref, refErr := reference(req.Reference)
+		if refErr != nil {
+			return nil, refErr
+		}
+		byReference = true
+		member, err = component.GetMember(ctx, s.db.Read(), ref.Bytes())
+	}
+	if err != nil {
+		return nil, s.failed(err)
+	}
+
+	deposits, err := component.GetDeposits(ctx, s.db.Read(), member.Reference, true)
+	if err != nil {
+		return nil, s.failed(err)
+	}
+	var burnedBalance *models.BurnedBalance
+	if insolar.NewReferenceFromBytes(member.Reference).Equal(appfoundation.GetMigrationAdminMember()) {
+		burnedBalance, err = component.GetBurnedBalance(s.db.Read())
+		if err != nil {
+			return nil, s.failed(err)
+		}
+	}
+	res, err := api.MemberToAPIMember(*member, deposits, burnedBalance, !byReference)
+	if err != nil {
+		return nil, s.failed(err)
+	}
+	return memberToRPC(res), nil
+}
+
+func (s *Server) GetBalance(ctx context.Context, req *GetBalanceRequest) (*Balance, error) {
+	ref, err := reference(req.Reference)
+	if err != nil {
+		return nil, err
+	}
+	member, err := component.GetMemberBalance(ctx, s.db.Read(), ref.Bytes())
+	if err != nil {
+		return nil, s.failed(err)
+	}
+	return &Balance{Balance: member.Balance}, nil
+}
+
+func (s *Server) ListMemberTransactions(ctx context.Context, req *ListMemberTransactionsRequest) (*Transactions, error) {
+	ref, err := reference(req.Reference)
+	if err != nil {
+		return nil, err
+	}
+	var direction *string
+	if req.Direction != "" {
+		direction = &req.Direction
+	}
+	query, err := component.FilterByMemberReferenceAndDirection(s.db.Read().ModelContext(ctx, &models.Transaction{}), ref, direction)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if query, err = filterByTime(query, req.FromTimestamp, req.ToTimestamp); err != nil {
+		return nil, err
+	}
+	return s.transactions(ctx, query, req.Status, req.Type, req.Index, req.Order, req.Limit)
+}
+
+func (s *Server) GetTransaction(ctx context.Context, req *GetTransactionRequest) (*Transaction, error) {
+	txID, err := insolar.NewRecordReferenceFromString(strings.TrimSpace(req.TxID))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "TxID has wrong format.")
+	}
+	tx, err := component.GetTx(ctx, s.db.Read(), txID.Bytes())
+	if err != nil {
+		return nil, s.failed(err)
+	}
+	txs := []models.Transaction{*tx}
+	if err := component.SetPulseDates(ctx, s.db.Read(), txs); err != nil {
+		return nil, s.failed(err)
+	}
+	return txToRPC(txs[0], models.TxIndexTypePulseRecord), nil
+}
+
+func (s *Server) SearchTransactions(ctx context.Context, req *SearchTransactionsRequest) (*Transactions, error) {
+	query := s.db.Read().ModelContext(ctx, &models.Transaction{})
+	if req.Value != "" {
+		var err error
+		query, err = component.FilterByValue(query, req.Value)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	query, err := filterByTime(query, req.FromTimestamp, req.ToTimestamp)
+	if err != nil {
+		return nil, err
+	}
+	return s.transactions(ctx, query, req.Status, req.Type, req.Index, req.Order, req.Limit)
+}
+
+func (s *Server) GetPulseRange(ctx context.Context, req *GetPulseRangeRequest) (*PulseRange, error) {
+	if req.Limit <= 0 || req.Limit > maxLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "Limit should be in range [1, %d].", maxLimit)
+	}
+	if req.FromTimestamp > req.ToTimestamp {
+		return nil, status.Error(codes.InvalidArgument, "FromTimestamp must chronologically precede ToTimestamp.")
+	}
+	var pulseNumber *int64
+	if req.PulseNumber != 0 {
+		pulseNumber = &req.PulseNumber
+	}
+	pulses, err := s.pStorage.GetRange(req.FromTimestamp, req.ToTimestamp, int(req.Limit), pulseNumber)
+	if err != nil {
+		return nil, s.failed(err)
+	}
+	res := &PulseRange{}
+	for _, p := range pulses {
+		res.PulseNumbers = append(res.PulseNumbers, int64(p))
+	}
+	return res, nil
+}
+
+func (s *Server) WatchTransactions(req *WatchTransactionsRequest, srv Observer_WatchTransactionsServer) error {
+	err := s.stream.Watch(srv.Context(), req.MemberReference, req.Type, req.Status, req.Index, func(tx models.Transaction) error {
+		return srv.Send(txToRPC(tx, models.TxIndexTypeChange))
+	})
+	switch err.(type) {
+	case nil:
+		return nil
+	case *api.FilterError:
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err == context.Canceled {
+		return nil
+	}
+	return status.Error(codes.Unavailable, err.Error())
+}
+
+// transactions applies the same filters, order and defaults as the REST transaction lists.
+func (s *Server) transactions(ctx context.Context, query *orm.Query, st, txType, index, order string, limit int32) (*Transactions, error) {
+	if limit <= 0 || limit > maxLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "Limit should be in range [1, %d].", maxLimit)
+	}
+	if st == "" {
+		st = string(models.TStatusRegistered)
+	}
+	query, err := component.FilterByStatus(query, st)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if txType != "" {
+		if query, err = component.FilterByType(query, txType); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	var pulse, record int64
+	if index != "" {
+		if pulse, record, err = parseIndex(index); err != nil {
+			return nil, err
+		}
+	}
+	var ord *string
+	if order != "" {
+		ord = &order
+	}
+	query, err = component.OrderByIndex(query, ord, pulse, record, index != "", models.TxIndexTypePulseRecord)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var txs []models.Transaction
+	if err := query.Limit(int(limit)).Select(&txs); err != nil {
+		return nil, s.failed(err)
+	}
+	if err := component.SetPulseDates(ctx, s.db.Read(), txs); err != nil {
+		return nil, s.failed(err)
+	}
+	res := &Transactions{}
+	for _, tx := range txs {
+		res.Transactions = append(res.Transactions, txToRPC(tx, models.TxIndexTypePulseRecord))
+	}
+	return res, nil
+}
+
+func (s *Server) failed(err error) error {
+	switch err {
+	case component.ErrReferenceNotFound, component.ErrTxNotFound:
+		return status.Error(codes.NotFound, "Not found.")
+	}
+	s.log.Error(err)
+	return errInternal
+}
+
+// filterByTime treats zero timestamps as not set.
+func filterByTime(query *orm.Query, from, to int64) (*orm.Query, error) {
+	var fromTimestamp, toTimestamp *int64
+	if from != 0 {
+		fromTimestamp = &from
+	}
+	if to != 0 {
+		toTimestamp = &to
+	}
+	query, err := component.FilterByTimestamp(query, fromTimestamp, toTimestamp, models.TxIndexTypePulseRecord)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return query, nil
+}
+
+func reference(ref string) (*insolar.Reference, error) {
+	res, err := insolar.NewReferenceFromString(strings.TrimSpace(ref))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Reference has wrong format.")
+	}
+	return res, nil
+}
+
+func parseIndex(index string) (int64, int64, error) {
+	invalid := status.Error(codes.InvalidArgument, "Index should have the '<pulse_number>:<sequence_number>' format.")
+	parts := strings.Split(index, ":")
+	if len(parts) != 2 {
+		return 0, 0, invalid
+	}
+	pulse, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return 0, 0, invalid
+	}
+	record, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return 0, 0, invalid
+	}
+	return pulse, record, nil
+}
+
+func memberToRPC(m api.ResponsesMemberYaml) *Member {
+	res := &Member{
+		Reference:        m.Reference,
+		WalletReference:  m.WalletReference,
+		AccountReference: m.AccountReference,
+		Balance:          m.Balance,
+	}
+	if m.MigrationAddress != nil {
+		res.MigrationAddress = *m.MigrationAddress
+	}
+	if m.BurnedBalance != nil {
+		res.BurnedBalance = *m.BurnedBalance
+	}
+	if m.Deposits != nil {
+		for _, d := range *m.Deposits {
+			deposit := &Deposit{
+				Index:            int64(d.Index),
+				DepositReference: d.DepositReference,
+				EthTxHash:        d.EthTxHash,
+				AmountOnHold:     d.AmountOnHold,
+				AvailableAmount:  d.AvailableAmount,
+				ReleasedAmount:   d.ReleasedAmount,
+				HoldReleaseDate:  d.HoldReleaseDate,
+				ReleaseEndDate:   d.ReleaseEndDate,
+				Status:           d.Status,
+				Timestamp:        d.Timestamp,
+			}
+			if d.MemberReference != nil {
+				deposit.MemberReference = *d.MemberReference
+			}
+			res.Deposits = append(res.Deposits, deposit)
+		}
+	}
+	return res
+}
+
+func txToRPC(tx models.Transaction, indexType models.TxIndexType) *Transaction {
+	ref := func(b []byte) string {
+		if len(b) == 0 {
+			return ""
+		}
+		return insolar.NewReferenceFromBytes(b).String()
+	}
+	return &Transaction{
+		TxID:                 ref(tx.TransactionID),
+		Index:                tx.Index(indexType),
+		Type:                 string(tx.Type),
+		Status:               string(tx.Status()),
+		Amount:               tx.Amount,
+		Fee:                  tx.Fee,
+		PulseNumber:          tx.PulseNumber(),
+		Timestamp:            tx.Timestamp(),
+		FromMemberReference:  ref(tx.MemberFromReference),
+		ToMemberReference:    ref(tx.MemberToReference),
+		FromDepositReference: ref(tx.DepositFromReference),
+		ToDepositReference:   ref(tx.DepositToReference),
+	}
+}
@@ -0,0 +1,53 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-pg/pg"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/insolar/observer/internal/dbconn"
+)
+
+func TestServer_InvalidArgument(t *testing.T) {
+	log := inslogger.FromContext(context.Background())
+	// Requests are rejected before queries are sent, the db isn't connected.
+	db := dbconn.NewPool(pg.Connect(&pg.Options{}), log)
+	s := NewServer(db, log, nil, nil)
+	ctx := context.Background()
+	member := gen.Reference().String()
+
+	_, err := s.GetBalance(ctx, &GetBalanceRequest{Reference: "invalid"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = s.GetMember(ctx, &GetMemberRequest{PublicKey: "invalid"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = s.GetTransaction(ctx, &GetTransactionRequest{TxID: "invalid"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = s.GetPulseRange(ctx, &GetPulseRangeRequest{Limit: 0})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = s.GetPulseRange(ctx, &GetPulseRangeRequest{Limit: 10, FromTimestamp: 2, ToTimestamp: 1})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	tests := []struct {
+		name string
+		req  *ListMemberTransactionsRequest
+	}{
+		{"limit", &ListMemberTransactionsRequest{Reference: member, Limit: 1001}},
+		{"direction", &ListMemberTransactionsRequest{Reference: member, Limit: 10, Direction: "up"}},
+		{"status", &ListMemberTransactionsRequest{Reference: member, Limit: 10, Status: "lost"}},
+		{"type", &ListMemberTransactionsRequest{Reference: member, Limit: 10, Type: "burn"}},
+		{"index", &ListMemberTransactionsRequest{Reference: member, Limit: 10, Index: "1-2"}},
+		{"order", &ListMemberTransactionsRequest{Reference: member, Limit: 10, Order: "random"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := s.ListMemberTransactions(ctx, test.req)
+			require.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/insolar/observer/internal/dbmigrate"
+)
+
+type Healthcheck struct {
+	Status   string `json:"status"`
+	ReadOnly bool   `json:"readOnly"`
+	dbmigrate.Schema
+}
+
+// HealthcheckHandler reports db schema version checked at startup.
+func HealthcheckHandler(schema dbmigrate.Schema, readOnly bool) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		return ctx.JSON(http.StatusOK, Healthcheck{Status: "OK", ReadOnly: readOnly, Schema: schema})
+	}
+}
+
+// ReadOnly rejects requests that could write to db.
+// Used when db schema version differs from the expected one and API is started in readonly mode.
+// Requests to safe paths only read with any method.
+func ReadOnly(safe ...string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			switch ctx.Request().Method {
+			case http.MethodGet, http.MethodHead, http.MethodOptions:
+				return next(ctx)
+			}
+			for _, path := range safe {
+				if ctx.Request().URL.Path == path {
+					return next(ctx)
+				}
+			}
+			return ctx.JSON(http.StatusServiceUnavailable,
+				NewSingleMessageError("API is in read-only mode because of db schema version mismatch"))
+		}
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/insolar/observer/configuration"
 	"github.com/insolar/observer/internal/app/observer"
 	"github.com/insolar/observer/internal/app/observer/postgres"
+	"github.com/insolar/observer/internal/dbconn"
 	"github.com/insolar/observer/internal/models"
 	"github.com/insolar/observer/internal/testutils"
 )
@@ -51,12 +52,15 @@ func TestMain(t *testing.M) {
 	nowPulse := 1575302444 - pulse.UnixTimeOfMinTimePulse + pulse.MinTimePulse
 	_ = pStorage.Insert(&observer.Pulse{Number: pulse.Number(nowPulse)})
 
-	observerAPI := NewObserverServer(db, logger, pStorage, configuration.APIExtended{
+	observerAPI := NewObserverServer(dbconn.NewPool(db, logger), logger, pStorage, configuration.APIExtended{
 		FeeAmount: testFee,
 		Price:     testPrice,
 	})
 
 	RegisterHandlers(e, observerAPI)
+	RegisterHistoryHandlers(e, observerAPI)
+	RegisterSupplyHandlers(e, observerAPI)
+	RegisterHoldersHandlers(e, observerAPI)
 
 	go func() {
 		err := e.Start(apihost)
@@ -8,7 +8,6 @@ import (
 	"strconv"
 	"time"
 
-	"github.com/go-pg/pg"
 	"github.com/insolar/insolar/insolar"
 	"github.com/labstack/echo/v4"
 	"github.com/pkg/errors"
@@ -17,24 +16,25 @@ import (
 	"github.com/insolar/observer/internal/app/api"
 	"github.com/insolar/observer/internal/app/observer"
 	"github.com/insolar/observer/internal/app/observer/postgres"
+	"github.com/insolar/observer/internal/dbconn"
 	"github.com/insolar/observer/internal/models"
 )
 
 type ObserverServer struct {
-	db       *pg.DB
+	db       *dbconn.Pool
 	log      insolar.Logger
 	pStorage observer.PulseStorage
 	config   configuration.APIConfig
 }
 
-func NewObserverServer(db *pg.DB, log insolar.Logger, pStorage observer.PulseStorage, config configuration.APIConfig) *ObserverServer {
+func NewObserverServer(db *dbconn.Pool, log insolar.Logger, pStorage observer.PulseStorage, config configuration.APIConfig) *ObserverServer {
 	return &ObserverServer{db: db, log: log, pStorage: pStorage, config: config}
 }
 
 func (s *ObserverServer) IsMigrationAddress(ctx echo.Context, ethereumAddress string) error {
 	s.setExpire(ctx, 1*time.Minute)
 
-	count, err := s.db.Model(&models.MigrationAddress{}).
+	count, err := s.db.Read().Model(&models.MigrationAddress{}).
 		Where("addr = ?", ethereumAddress).
 		Count()
 
@@ -54,7 +54,7 @@ func (s *ObserverServer) GetMigrationAddresses(ctx echo.Context, params GetMigra
 		return ctx.JSON(http.StatusBadRequest, api.NewSingleMessageError("`limit` should be in range [1, 1000]"))
 	}
 
-	query := s.db.Model(&models.MigrationAddress{}).
+	query := s.db.Read().Model(&models.MigrationAddress{}).
 		Where("wasted = false")
 	if params.Index != nil {
 		id, err := strconv.ParseInt(*params.Index, 10, 64)
@@ -83,7 +83,7 @@ func (s *ObserverServer) GetMigrationAddresses(ctx echo.Context, params GetMigra
 func (s *ObserverServer) GetMigrationAddressCount(ctx echo.Context) error {
 	s.setExpire(ctx, 10*time.Second)
 
-	count, err := s.db.Model(&models.MigrationAddress{}).
+	count, err := s.db.Read().Model(&models.MigrationAddress{}).
 		Where("wasted = false").
 		Count()
 	if err != nil {
@@ -114,7 +114,7 @@ func (s *ObserverServer) MarketStats(ctx echo.Context) error {
 	}
 	switch s.config.GetPriceOrigin() {
 	case "binance":
-		repo := postgres.NewBinanceStatsRepository(s.db)
+		repo := postgres.NewBinanceStatsRepository(s.db.Read())
 		stats, err := repo.LastStats()
 		if err != nil {
 			s.log.Error(errors.Wrap(err, "couldn't get last binance supply stats"))
@@ -141,7 +141,7 @@ func (s *ObserverServer) MarketStats(ctx echo.Context) error {
 			}
 			return nil
 		}
-		repo := postgres.NewCoinMarketCapStatsRepository(s.db)
+		repo := postgres.NewCoinMarketCapStatsRepository(s.db.Read())
 		stats, err := repo.LastStats()
 		if err != nil {
 			s.log.Error(errors.Wrap(err, "couldn't get last coin market cap supply stats"))
@@ -171,7 +171,7 @@ func (s *ObserverServer) MarketStats(ctx echo.Context) error {
 }
 
 func (s *ObserverServer) totalSupply() (*string, error) {
-	repo := postgres.NewSupplyStatsRepository(s.db)
+	repo := postgres.NewSupplyStatsRepository(s.db.Read())
 	result, err := repo.LastStats()
 	if err != nil && err == postgres.ErrNoStats {
 		return nil, nil
@@ -186,7 +186,7 @@ func (s *ObserverServer) totalSupply() (*string, error) {
 func (s *ObserverServer) NetworkStats(ctx echo.Context) error {
 	s.setExpire(ctx, 10*time.Second)
 
-	repo := postgres.NewNetworkStatsRepository(s.db)
+	repo := postgres.NewNetworkStatsRepository(s.db.Read())
 	result, err := repo.LastStats()
 	if err != nil {
 		return ctx.JSON(http.StatusInternalServerError, "")
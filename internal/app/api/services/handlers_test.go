@@ -18,6 +18,7 @@ import (
 
 	"github.com/insolar/observer/configuration"
 	"github.com/insolar/observer/internal/app/observer/postgres"
+	"github.com/insolar/observer/internal/dbconn"
 	"github.com/insolar/observer/internal/models"
 )
 
@@ -239,7 +240,7 @@ func TestObserverServer_CMC_Price(t *testing.T) {
 	require.NoError(t, err)
 
 	logger := inslogger.FromContext(context.Background())
-	observerAPI := NewObserverServer(db, logger, pStorage, configuration.APIExtended{
+	observerAPI := NewObserverServer(dbconn.NewPool(db, logger), logger, pStorage, configuration.APIExtended{
 		FeeAmount:   testFee,
 		Price:       testPrice,
 		PriceOrigin: "coin_market_cap",
@@ -340,7 +341,7 @@ func TestObserverServer_Binance_Price(t *testing.T) {
 	require.NoError(t, err)
 
 	logger := inslogger.FromContext(context.Background())
-	observerAPI := NewObserverServer(db, logger, pStorage, configuration.APIExtended{
+	observerAPI := NewObserverServer(dbconn.NewPool(db, logger), logger, pStorage, configuration.APIExtended{
 		FeeAmount:   testFee,
 		Price:       testPrice,
 		PriceOrigin: "binance",
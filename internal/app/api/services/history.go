@@ -0,0 +1,203 @@
+// +build !node
+
+package services
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+	"github.com/labstack/echo/v4"
+
+	"github.com/insolar/observer/component"
+	"github.com/insolar/observer/internal/app/api"
+)
+
+const (
+	historyFormatJSON = "json"
+	historyFormatCSV  = "csv"
+
+	// Limits the number of points in a response, it is more than a year of hours.
+	maxHistoryPoints = 10000
+	// Range when `from` is omitted.
+	defaultHistoryRange = 30 * 24 * time.Hour
+)
+
+var historyIntervals = map[string]time.Duration{
+	"hour": time.Hour,
+	"day":  24 * time.Hour,
+	"week": 7 * 24 * time.Hour,
+}
+
+// HistoryServer serves stats time series, the endpoints aren't a part of the generated API.
+type HistoryServer interface {
+	NetworkStatsHistory(ctx echo.Context) error
+	SupplyStatsHistory(ctx echo.Context) error
+}
+
+func RegisterHistoryHandlers(router runtime.EchoRouter, s HistoryServer) {
+	router.GET("/api/stats/network/history", s.NetworkStatsHistory)
+	router.GET("/api/stats/supply/history", s.SupplyStatsHistory)
+}
+
+type ResponsesNetworkStatsPoint struct {
+	Timestamp             int64 `json:"timestamp"`
+	PulseNumber           int   `json:"pulseNumber"`
+	Accounts              int   `json:"accounts"`
+	CurrentTPS            int   `json:"currentTPS"`
+	MaxTPS                int   `json:"maxTPS"`
+	LastMonthTransactions int   `json:"lastMonthTransactions"`
+	Nodes                 int   `json:"nodes"`
+	TotalTransactions     int   `json:"totalTransactions"`
+}
+
+type ResponsesSupplyStatsPoint struct {
+	Timestamp   int64  `json:"timestamp"`
+	TotalSupply string `json:"totalSupply"`
+}
+
+type historyParams struct {
+	from, to time.Time
+	interval string
+	format   string
+}
+
+// NetworkStatsHistory responds with the last network stats in every interval between `from` and `to`.
+func (s *ObserverServer) NetworkStatsHistory(ctx echo.Context) error {
+	params, errMsg := checkHistoryParams(ctx)
+	if errMsg != nil {
+		return ctx.JSON(http.StatusBadRequest, *errMsg)
+	}
+	stats, err := component.GetNetworkStatsHistory(ctx.Request().Context(), s.db.Read(), params.from, params.to, params.interval)
+	if err != nil {
+		s.log.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, struct{}{})
+	}
+	s.setExpire(ctx, 1*time.Minute)
+
+	res := make([]ResponsesNetworkStatsPoint, 0, len(stats))
+	for _, st := range stats {
+		res = append(res, ResponsesNetworkStatsPoint{
+			Timestamp:             st.Created.Unix(),
+			PulseNumber:           st.PulseNumber,
+			Accounts:              st.TotalAccounts,
+			CurrentTPS:            st.CurrentTPS,
+			MaxTPS:                st.MaxTPS,
+			LastMonthTransactions: st.MonthTransactions,
+			Nodes:                 st.Nodes,
+			TotalTransactions:     st.TotalTransactions,
+		})
+	}
+	if params.format == historyFormatJSON {
+		return ctx.JSON(http.StatusOK, res)
+	}
+
+	rows := make([][]string, 0, len(res)+1)
+	rows = append(rows, []string{
+		"timestamp", "pulse_number", "accounts", "current_tps", "max_tps", "last_month_transactions", "nodes", "total_transactions",
+	})
+	for _, p := range res {
+		rows = append(rows, []string{
+			strconv.FormatInt(p.Timestamp, 10), strconv.Itoa(p.PulseNumber), strconv.Itoa(p.Accounts),
+			strconv.Itoa(p.CurrentTPS), strconv.Itoa(p.MaxTPS), strconv.Itoa(p.LastMonthTransactions),
+			strconv.Itoa(p.Nodes), strconv.Itoa(p.TotalTransactions),
+		})
+	}
+	return writeHistoryCSV(ctx, "network", params, rows)
+}
+
+// SupplyStatsHistory responds with the last total supply in XNS in every interval between `from` and `to`.
+func (s *ObserverServer) SupplyStatsHistory(ctx echo.Context) error {
+	params, errMsg := checkHistoryParams(ctx)
+	if errMsg != nil {
+		return ctx.JSON(http.StatusBadRequest, *errMsg)
+	}
+	stats, err := component.GetSupplyStatsHistory(ctx.Request().Context(), s.db.Read(), params.from, params.to, params.interval)
+	if err != nil {
+		s.log.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, struct{}{})
+	}
+	s.setExpire(ctx, 1*time.Minute)
+
+	res := make([]ResponsesSupplyStatsPoint, 0, len(stats))
+	for _, st := range stats {
+		res = append(res, ResponsesSupplyStatsPoint{
+			Timestamp:   st.Created.Unix(),
+			TotalSupply: st.TotalInXNS(),
+		})
+	}
+	if params.format == historyFormatJSON {
+		return ctx.JSON(http.StatusOK, res)
+	}
+
+	rows := make([][]string, 0, len(res)+1)
+	rows = append(rows, []string{"timestamp", "total_supply"})
+	for _, p := range res {
+		rows = append(rows, []string{strconv.FormatInt(p.Timestamp, 10), p.TotalSupply})
+	}
+	return writeHistoryCSV(ctx, "supply", params, rows)
+}
+
+// checkHistoryParams reads `from` and `to` unix timestamps, `interval` (hour, day or week, day by default)
+// and `format` (json or csv, json by default). The last 30 days are returned by default.
+func checkHistoryParams(ctx echo.Context) (historyParams, *api.ErrorMessage) {
+	var errorMsg api.ErrorMessage
+	params := historyParams{
+		to:       time.Now(),
+		interval: ctx.QueryParam("interval"),
+		format:   ctx.QueryParam("format"),
+	}
+	if v := ctx.QueryParam("to"); v != "" {
+		to, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			errorMsg.Error = append(errorMsg.Error, "Query parameter 'to' should be unix timestamp.")
+		}
+		params.to = time.Unix(to, 0)
+	}
+	params.from = params.to.Add(-defaultHistoryRange)
+	if v := ctx.QueryParam("from"); v != "" {
+		from, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			errorMsg.Error = append(errorMsg.Error, "Query parameter 'from' should be unix timestamp.")
+		}
+		params.from = time.Unix(from, 0)
+	}
+	if params.from.After(params.to) {
+		errorMsg.Error = append(errorMsg.Error, "Query parameter 'from' should not be after 'to'.")
+	}
+
+	if params.interval == "" {
+		params.interval = "day"
+	}
+	step, ok := historyIntervals[params.interval]
+	if !ok {
+		errorMsg.Error = append(errorMsg.Error, "Query parameter 'interval' should be 'hour', 'day' or 'week'.")
+	} else if params.to.Sub(params.from)/step > maxHistoryPoints {
+		errorMsg.Error = append(errorMsg.Error, fmt.Sprintf("Range is too long, at most %d intervals are allowed.", maxHistoryPoints))
+	}
+
+	if params.format == "" {
+		params.format = historyFormatJSON
+	}
+	if params.format != historyFormatJSON && params.format != historyFormatCSV {
+		errorMsg.Error = append(errorMsg.Error, "Query parameter 'format' should be 'json' or 'csv'.")
+	}
+
+	if len(errorMsg.Error) > 0 {
+		return params, &errorMsg
+	}
+	return params, nil
+}
+
+func writeHistoryCSV(ctx echo.Context, name string, params historyParams, rows [][]string) error {
+	header := ctx.Response().Header()
+	filename := fmt.Sprintf("%s-%s-%d-%d.csv", name, params.interval, params.from.Unix(), params.to.Unix())
+	header.Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", filename))
+	header.Set(echo.HeaderContentType, "text/csv; charset=utf-8")
+	ctx.Response().WriteHeader(http.StatusOK)
+
+	return csv.NewWriter(ctx.Response()).WriteAll(rows)
+}
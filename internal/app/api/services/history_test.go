@@ -0,0 +1,71 @@
+// +build !node
+
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/observer/internal/app/observer/postgres"
+	"github.com/insolar/observer/internal/models"
+)
+
+func TestNetworkStatsHistory(t *testing.T) {
+	start := time.Date(2020, 1, 1, 10, 0, 0, 0, time.UTC)
+	repo := postgres.NewNetworkStatsRepository(db)
+	for i, st := range []models.NetworkStats{
+		{Created: start.Add(5 * time.Minute), TotalAccounts: 1, MaxTPS: 100},
+		{Created: start.Add(40 * time.Minute), TotalAccounts: 2, MaxTPS: 50},
+		{Created: start.Add(70 * time.Minute), TotalAccounts: 3, MaxTPS: 10},
+	} {
+		st.PulseNumber = i
+		require.NoError(t, repo.InsertStats(st))
+	}
+
+	resp, err := http.Get(fmt.Sprintf("http://%s/api/stats/network/history?from=%d&to=%d&interval=hour",
+		apihost, start.Unix(), start.Add(2*time.Hour).Unix()))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	bodyBytes, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	var received []ResponsesNetworkStatsPoint
+	require.NoError(t, json.Unmarshal(bodyBytes, &received))
+	require.Len(t, received, 2)
+	require.Equal(t, start.Unix(), received[0].Timestamp)
+	require.Equal(t, 2, received[0].Accounts)
+	require.Equal(t, 100, received[0].MaxTPS)
+	require.Equal(t, start.Add(time.Hour).Unix(), received[1].Timestamp)
+	require.Equal(t, 3, received[1].Accounts)
+}
+
+func TestSupplyStatsHistory_CSV(t *testing.T) {
+	start := time.Date(2020, 2, 1, 0, 0, 0, 0, time.UTC)
+	for i, total := range []string{"10000000000", "20000000000", "30000000000"} {
+		_, err := db.Exec("insert into supply_stats (created, total) values (?, ?)", start.Add(time.Duration(i)*12*time.Hour), total)
+		require.NoError(t, err)
+	}
+
+	resp, err := http.Get(fmt.Sprintf("http://%s/api/stats/supply/history?from=%d&to=%d&format=csv",
+		apihost, start.Unix(), start.Add(48*time.Hour).Unix()))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "text/csv; charset=utf-8", resp.Header.Get("Content-Type"))
+	bodyBytes, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	expected := fmt.Sprintf("timestamp,total_supply\n%d,2.0000000000\n%d,3.0000000000\n", start.Unix(), start.Add(24*time.Hour).Unix())
+	require.Equal(t, expected, string(bodyBytes))
+}
+
+func TestStatsHistory_WrongArguments(t *testing.T) {
+	for _, query := range []string{"interval=month", "format=xml", "from=yesterday", "from=100&to=10", "from=0&to=100000000&interval=hour"} {
+		resp, err := http.Get("http://" + apihost + "/api/stats/network/history?" + query)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode, query)
+	}
+}
@@ -0,0 +1,148 @@
+// +build !node
+
+package services
+
+import (
+	"fmt"
+	"math/big"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+	"github.com/insolar/insolar/insolar"
+	"github.com/labstack/echo/v4"
+
+	"github.com/insolar/observer/internal/app/api"
+	"github.com/insolar/observer/internal/app/observer/postgres"
+	"github.com/insolar/observer/internal/models"
+)
+
+const defaultHoldersLimit = 10
+
+// HoldersServer serves snapshots of holders made by the stats collector, the endpoints aren't a part of the generated API.
+type HoldersServer interface {
+	Holders(ctx echo.Context) error
+	Distribution(ctx echo.Context) error
+}
+
+func RegisterHoldersHandlers(router runtime.EchoRouter, s HoldersServer) {
+	router.GET("/api/stats/holders", s.Holders)
+	router.GET("/api/stats/distribution", s.Distribution)
+}
+
+// ResponsesHolders is the top of the last holders snapshot, balances are in XNS.
+type ResponsesHolders struct {
+	Timestamp int64             `json:"timestamp"`
+	Holders   []ResponsesHolder `json:"holders"`
+}
+
+type ResponsesHolder struct {
+	Rank           int    `json:"rank"`
+	Reference      string `json:"reference"`
+	AccountBalance string `json:"accountBalance"`
+	DepositBalance string `json:"depositBalance"`
+	Balance        string `json:"balance"`
+}
+
+// ResponsesDistribution is the last balance distribution. Gini is from 0 (equal balances) to 1,
+// shares are parts of the total balance held by the top holders.
+type ResponsesDistribution struct {
+	Timestamp   int64                         `json:"timestamp"`
+	Holders     int64                         `json:"holders"`
+	Total       string                        `json:"total"`
+	Gini        float64                       `json:"gini"`
+	Top10Share  float64                       `json:"top10Share"`
+	Top100Share float64                       `json:"top100Share"`
+	Buckets     []ResponsesDistributionBucket `json:"buckets"`
+}
+
+// ResponsesDistributionBucket counts holders with balance from `from` up to `to` XNS, the last bucket has no `to`.
+type ResponsesDistributionBucket struct {
+	From    string  `json:"from"`
+	To      *string `json:"to,omitempty"`
+	Holders int64   `json:"holders"`
+	Balance string  `json:"balance"`
+}
+
+// Holders responds with the biggest holders by account plus deposit balance, `limit` is 10 by default.
+func (s *ObserverServer) Holders(ctx echo.Context) error {
+	limit := defaultHoldersLimit
+	if v := ctx.QueryParam("limit"); v != "" {
+		var err error
+		limit, err = strconv.Atoi(v)
+		if err != nil || limit < 1 || limit > postgres.HoldersTopSize {
+			return ctx.JSON(http.StatusBadRequest, api.NewSingleMessageError(
+				fmt.Sprintf("Query parameter 'limit' should be from 1 to %d.", postgres.HoldersTopSize)))
+		}
+	}
+	stats, err := postgres.NewHoldersStatsRepository(s.db.Read()).LastStats(limit)
+	if err == postgres.ErrNoStats {
+		return ctx.NoContent(http.StatusNoContent)
+	}
+	if err != nil {
+		s.log.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, struct{}{})
+	}
+	s.setExpire(ctx, 1*time.Minute)
+
+	res := ResponsesHolders{Timestamp: stats.Distribution.Created.Unix(), Holders: make([]ResponsesHolder, 0, len(stats.Top))}
+	for _, h := range stats.Top {
+		res.Holders = append(res.Holders, ResponsesHolder{
+			Rank:           h.Rank,
+			Reference:      insolar.NewReferenceFromBytes(h.Reference).String(),
+			AccountBalance: models.ConvertCoinsToXNS(h.AccountBalance),
+			DepositBalance: models.ConvertCoinsToXNS(h.DepositBalance),
+			Balance:        models.ConvertCoinsToXNS(h.Balance),
+		})
+	}
+	return ctx.JSON(http.StatusOK, res)
+}
+
+// Distribution responds with holder counts per balance bucket and concentration of balances.
+func (s *ObserverServer) Distribution(ctx echo.Context) error {
+	stats, err := postgres.NewHoldersStatsRepository(s.db.Read()).LastDistribution()
+	if err == postgres.ErrNoStats {
+		return ctx.NoContent(http.StatusNoContent)
+	}
+	if err != nil {
+		s.log.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, struct{}{})
+	}
+	s.setExpire(ctx, 1*time.Minute)
+	return ctx.JSON(http.StatusOK, distributionResponse(stats))
+}
+
+func distributionResponse(stats postgres.HoldersStats) ResponsesDistribution {
+	d := stats.Distribution
+	res := ResponsesDistribution{
+		Timestamp:   d.Created.Unix(),
+		Holders:     d.Holders,
+		Total:       models.ConvertCoinsToXNS(d.Total),
+		Gini:        d.Gini,
+		Top10Share:  d.Top10Share,
+		Top100Share: d.Top100Share,
+		Buckets:     make([]ResponsesDistributionBucket, 0, len(stats.Buckets)),
+	}
+	for i, b := range stats.Buckets {
+		bucket := ResponsesDistributionBucket{
+			From:    xnsBound(b.LowerBound),
+			Holders: b.Holders,
+			Balance: models.ConvertCoinsToXNS(b.Balance),
+		}
+		if i+1 < len(stats.Buckets) {
+			bucket.To = api.NullableString(xnsBound(stats.Buckets[i+1].LowerBound))
+		}
+		res.Buckets = append(res.Buckets, bucket)
+	}
+	return res
+}
+
+// xnsBound formats bucket bounds, they are whole XNS.
+func xnsBound(coins string) string {
+	v, ok := new(big.Int).SetString(coins, 10)
+	if !ok {
+		return models.ConvertCoinsToXNS(coins)
+	}
+	return v.Quo(v, big.NewInt(10000000000)).String()
+}
@@ -0,0 +1,70 @@
+// +build !node
+
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/observer/internal/app/observer/postgres"
+	"github.com/insolar/observer/internal/models"
+)
+
+func TestHolders(t *testing.T) {
+	defer func() {
+		for _, m := range []interface{}{&models.HolderStats{}, &models.DistributionStats{}, &models.DistributionBucket{}} {
+			_, err := db.Model(m).Exec("TRUNCATE TABLE ?TableName")
+			require.NoError(t, err)
+		}
+	}()
+	created := time.Now().Add(-time.Minute)
+	ref := gen.Reference()
+	err := postgres.NewHoldersStatsRepository(db).InsertStats(postgres.HoldersStats{
+		Top: []models.HolderStats{
+			{Created: created, Rank: 1, Reference: ref.Bytes(), AccountBalance: "10000000000", DepositBalance: "20000000000", Balance: "30000000000"},
+			{Created: created, Rank: 2, Reference: gen.Reference().Bytes(), AccountBalance: "10000000000", DepositBalance: "0", Balance: "10000000000"},
+		},
+		Distribution: models.DistributionStats{Created: created, Holders: 2, Total: "40000000000", Gini: 0.25, Top10Share: 1, Top100Share: 1},
+	})
+	require.NoError(t, err)
+
+	resp, err := http.Get(fmt.Sprintf("http://%s/api/stats/holders?limit=1", apihost))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	bodyBytes, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	var received ResponsesHolders
+	require.NoError(t, json.Unmarshal(bodyBytes, &received))
+	require.Equal(t, created.Unix(), received.Timestamp)
+	require.Len(t, received.Holders, 1)
+	require.Equal(t, ref.String(), received.Holders[0].Reference)
+	require.Equal(t, "3.0000000000", received.Holders[0].Balance)
+
+	resp, err = http.Get(fmt.Sprintf("http://%s/api/stats/holders?limit=101", apihost))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestDistributionResponse(t *testing.T) {
+	res := distributionResponse(postgres.HoldersStats{
+		Distribution: models.DistributionStats{Holders: 3, Total: "50000000000", Gini: 0.5},
+		Buckets: []models.DistributionBucket{
+			{LowerBound: "0", Holders: 1, Balance: "5000000000"},
+			{LowerBound: "10000000000", Holders: 2, Balance: "45000000000"},
+		},
+	})
+	require.Equal(t, "5.0000000000", res.Total)
+	require.Len(t, res.Buckets, 2)
+	require.Equal(t, "0", res.Buckets[0].From)
+	require.Equal(t, "1", *res.Buckets[0].To)
+	require.Equal(t, "1", res.Buckets[1].From)
+	require.Nil(t, res.Buckets[1].To)
+	require.Equal(t, "4.5000000000", res.Buckets[1].Balance)
+}
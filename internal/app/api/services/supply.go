@@ -0,0 +1,67 @@
+// +build !node
+
+package services
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+	"github.com/labstack/echo/v4"
+
+	"github.com/insolar/observer/internal/app/api"
+	"github.com/insolar/observer/internal/app/observer/postgres"
+	"github.com/insolar/observer/internal/models"
+)
+
+// SupplyServer serves the supply breakdown, the endpoint isn't a part of the generated API.
+type SupplyServer interface {
+	SupplyStats(ctx echo.Context) error
+}
+
+func RegisterSupplyHandlers(router runtime.EchoRouter, s SupplyServer) {
+	router.GET("/api/stats/supply", s.SupplyStats)
+}
+
+// ResponsesSupplyStats is the last collected supply in XNS. The parts of total are empty
+// if the last stats were collected before the breakdown was introduced.
+type ResponsesSupplyStats struct {
+	Timestamp   int64   `json:"timestamp"`
+	Total       string  `json:"total"`
+	Circulating *string `json:"circulating,omitempty"`
+	Locked      *string `json:"locked,omitempty"`
+	Vesting     *string `json:"vesting,omitempty"`
+	Burned      *string `json:"burned,omitempty"`
+}
+
+// SupplyStats responds with total supply split into circulating, locked and vesting parts and the burned amount.
+func (s *ObserverServer) SupplyStats(ctx echo.Context) error {
+	repo := postgres.NewSupplyStatsRepository(s.db.Read())
+	stats, err := repo.LastStats()
+	if err == postgres.ErrNoStats {
+		return ctx.NoContent(http.StatusNoContent)
+	}
+	if err != nil {
+		s.log.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, struct{}{})
+	}
+	s.setExpire(ctx, 1*time.Minute)
+	return ctx.JSON(http.StatusOK, supplyStatsResponse(stats))
+}
+
+func supplyStatsResponse(stats models.SupplyStats) ResponsesSupplyStats {
+	xns := func(coins string) *string {
+		if coins == "" {
+			return nil
+		}
+		return api.NullableString(models.ConvertCoinsToXNS(coins))
+	}
+	return ResponsesSupplyStats{
+		Timestamp:   stats.Created.Unix(),
+		Total:       stats.TotalInXNS(),
+		Circulating: xns(stats.Circulating),
+		Locked:      xns(stats.Locked),
+		Vesting:     xns(stats.Vesting),
+		Burned:      xns(stats.Burned),
+	}
+}
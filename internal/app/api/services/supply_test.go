@@ -0,0 +1,48 @@
+// +build !node
+
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/observer/internal/models"
+)
+
+func TestSupplyStats(t *testing.T) {
+	defer func() {
+		_, err := db.Model(&models.SupplyStats{}).Exec("TRUNCATE TABLE ?TableName")
+		require.NoError(t, err)
+	}()
+	created := time.Now().Add(-time.Minute)
+	_, err := db.Exec("insert into supply_stats (created, total, circulating, locked, vesting, burned) values (?, ?, ?, ?, ?, ?)",
+		created, "100000000000", "25000000000", "50000000000", "25000000000", "5000000000")
+	require.NoError(t, err)
+
+	resp, err := http.Get(fmt.Sprintf("http://%s/api/stats/supply", apihost))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	bodyBytes, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	var received ResponsesSupplyStats
+	require.NoError(t, json.Unmarshal(bodyBytes, &received))
+	require.Equal(t, created.Unix(), received.Timestamp)
+	require.Equal(t, "10.0000000000", received.Total)
+	require.Equal(t, "2.5000000000", *received.Circulating)
+	require.Equal(t, "5.0000000000", *received.Locked)
+	require.Equal(t, "2.5000000000", *received.Vesting)
+	require.Equal(t, "0.5000000000", *received.Burned)
+}
+
+func TestSupplyStatsResponse_Legacy(t *testing.T) {
+	res := supplyStatsResponse(models.SupplyStats{Created: time.Unix(1600000000, 0), Total: "10000000000"})
+	require.Equal(t, "1.0000000000", res.Total)
+	require.Nil(t, res.Circulating)
+	require.Nil(t, res.Burned)
+}
@@ -0,0 +1,288 @@
+package api
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+	"github.com/insolar/insolar/insolar"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/insolar/observer/component"
+	"github.com/insolar/observer/internal/models"
+)
+
+const (
+	StatementPath = "/api/member/:reference/statement"
+
+	statementFormatCSV    = "csv"
+	statementFormatNDJSON = "ndjson"
+
+	statementBatch = 1000
+)
+
+// StatementServer serves account statements, the endpoint isn't a part of the generated API.
+type StatementServer interface {
+	Statement(ctx echo.Context, reference string) error
+}
+
+func RegisterStatementHandler(router runtime.EchoRouter, s StatementServer) {
+	router.GET(StatementPath, func(ctx echo.Context) error {
+		return s.Statement(ctx, ctx.Param("reference"))
+	})
+}
+
+// StatementLine is a transaction of the member with the account balance after it.
+type StatementLine struct {
+	Index        string `json:"index"`
+	TxID         string `json:"txID"`
+	Timestamp    int64  `json:"timestamp"`
+	PulseNumber  int64  `json:"pulseNumber"`
+	Type         string `json:"type"`
+	Status       string `json:"status"`
+	Direction    string `json:"direction"`
+	Counterparty string `json:"counterparty"`
+	Amount       string `json:"amount"`
+	Fee          string `json:"fee"`
+	Balance      string `json:"balance"`
+}
+
+var statementCSVHeader = []string{
+	"index", "txID", "time", "pulseNumber", "type", "status", "direction", "counterparty", "amount", "fee", "balance",
+}
+
+func (l StatementLine) csv() []string {
+	return []string{
+		l.Index, l.TxID, time.Unix(l.Timestamp, 0).UTC().Format(time.RFC3339), strconv.FormatInt(l.PulseNumber, 10),
+		l.Type, l.Status, l.Direction, l.Counterparty, l.Amount, l.Fee, l.Balance,
+	}
+}
+
+// Statement streams all transactions of the member between `from` and `to` unix timestamps in csv or ndjson `format`.
+// Amounts are in XNS, balance is the member account balance after the transaction.
+// Opening and closing balances are returned in `X-Opening-Balance` and `X-Closing-Balance` headers.
+func (s *ObserverServer) Statement(ctx echo.Context, reference string) error {
+	ref, errMsg := s.checkReference(reference)
+	if errMsg != nil {
+		return ctx.JSON(http.StatusBadRequest, *errMsg)
+	}
+
+	var errorMsg ErrorMessage
+	from, err := strconv.ParseInt(ctx.QueryParam("from"), 10, 64)
+	if err != nil {
+		errorMsg.Error = append(errorMsg.Error, "Query parameter 'from' should be unix timestamp.")
+	}
+	to, err := strconv.ParseInt(ctx.QueryParam("to"), 10, 64)
+	if err != nil {
+		errorMsg.Error = append(errorMsg.Error, "Query parameter 'to' should be unix timestamp.")
+	}
+	if from > to {
+		errorMsg.Error = append(errorMsg.Error, "Invalid input range: from must chronologically precede to")
+	}
+	format := ctx.QueryParam("format")
+	if format == "" {
+		format = statementFormatCSV
+	}
+	if format != statementFormatCSV && format != statementFormatNDJSON {
+		errorMsg.Error = append(errorMsg.Error, "Query parameter 'format' should be 'csv' or 'ndjson'.")
+	}
+	if len(errorMsg.Error) > 0 {
+		return ctx.JSON(http.StatusBadRequest, errorMsg)
+	}
+
+	reqCtx := ctx.Request().Context()
+	db := s.db.Read()
+	fromPulse, toPulse, err := component.GetPulseRangeByTime(reqCtx, db, from, to)
+	if err != nil {
+		s.log.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, struct{}{})
+	}
+
+	member, err := component.GetMemberBalance(reqCtx, db, ref.Bytes())
+	if err != nil {
+		if err == component.ErrReferenceNotFound {
+			return ctx.NoContent(http.StatusNoContent)
+		}
+		s.log.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, struct{}{})
+	}
+	current, ok := new(big.Int).SetString(member.Balance, 10)
+	if !ok {
+		s.log.Error(errors.Errorf("invalid balance %q of member %s", member.Balance, ref.String()))
+		return ctx.JSON(http.StatusInternalServerError, struct{}{})
+	}
+	// Balance is restored backwards from the current one, pulse_record can't be greater than max int64.
+	after, err := component.GetMemberBalanceChange(reqCtx, db, ref.Bytes(), toPulse, 1<<62)
+	if err != nil {
+		s.log.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, struct{}{})
+	}
+	within, err := component.GetMemberBalanceChange(reqCtx, db, ref.Bytes(), fromPulse-1, toPulse)
+	if err != nil {
+		s.log.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, struct{}{})
+	}
+	closing := new(big.Int).Sub(current, after)
+	opening := new(big.Int).Sub(closing, within)
+
+	header := ctx.Response().Header()
+	header.Set("X-Opening-Balance", coinsToXNS(opening))
+	header.Set("X-Closing-Balance", coinsToXNS(closing))
+	filename := fmt.Sprintf("statement-%d-%d.%s", from, to, format)
+	header.Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", filename))
+	if format == statementFormatCSV {
+		header.Set(echo.HeaderContentType, "text/csv; charset=utf-8")
+	} else {
+		header.Set(echo.HeaderContentType, "application/x-ndjson")
+	}
+	ctx.Response().WriteHeader(http.StatusOK)
+
+	write := statementWriter(ctx.Response(), format)
+	if format == statementFormatCSV {
+		if err := write.csv.Write(statementCSVHeader); err != nil {
+			return err
+		}
+	}
+
+	// Headers are sent already, so errors below only break the stream.
+	balance := opening
+	var last *models.Transaction
+	for {
+		var txs []models.Transaction
+		query := db.Model(&txs)
+		query, _ = component.FilterByMemberReferenceAndDirection(query, ref, nil)
+		query, _ = component.FilterByPulse(query, fromPulse, toPulse)
+		if last != nil {
+			query = query.Where("pulse_record > array[?,?]::bigint[]", last.PulseRecord[0], last.PulseRecord[1])
+		}
+		if err := query.Order("pulse_record ASC").Limit(statementBatch).Select(); err != nil {
+			s.log.Error(errors.Wrap(err, "failed to select statement transactions"))
+			return nil
+		}
+		if err := component.SetPulseDates(reqCtx, db, txs); err != nil {
+			s.log.Error(err)
+			return nil
+		}
+
+		for i := range txs {
+			line := statementLine(txs[i], ref, balance)
+			if err := write.line(line); err != nil {
+				s.log.Error(errors.Wrap(err, "failed to write statement"))
+				return nil
+			}
+		}
+		if err := write.flush(); err != nil {
+			s.log.Error(errors.Wrap(err, "failed to write statement"))
+			return nil
+		}
+		ctx.Response().Flush()
+
+		if len(txs) < statementBatch {
+			return nil
+		}
+		last = &txs[len(txs)-1]
+	}
+}
+
+// statementLine makes a line for tx and applies it to balance if the transaction succeeded.
+func statementLine(tx models.Transaction, member *insolar.Reference, balance *big.Int) StatementLine {
+	outgoing := bytes.Equal(tx.MemberFromReference, member.Bytes())
+	incoming := bytes.Equal(tx.MemberToReference, member.Bytes())
+
+	line := StatementLine{
+		Index:       tx.Index(models.TxIndexTypePulseRecord),
+		TxID:        insolar.NewReferenceFromBytes(tx.TransactionID).String(),
+		Timestamp:   tx.Timestamp(),
+		PulseNumber: tx.PulseNumber(),
+		Type:        string(tx.Type),
+		Status:      string(tx.Status()),
+		Amount:      models.ConvertCoinsToXNS(tx.Amount),
+	}
+
+	var counterparty []byte
+	switch {
+	case outgoing && incoming:
+		line.Direction = "self"
+		counterparty = tx.MemberToReference
+	case outgoing:
+		line.Direction = "outgoing"
+		counterparty = tx.MemberToReference
+		if len(tx.DepositToReference) > 0 {
+			counterparty = tx.DepositToReference
+		}
+	default:
+		line.Direction = "incoming"
+		counterparty = tx.MemberFromReference
+		if len(tx.DepositFromReference) > 0 {
+			counterparty = tx.DepositFromReference
+		}
+	}
+	if len(counterparty) > 0 {
+		line.Counterparty = insolar.NewReferenceFromBytes(counterparty).String()
+	}
+	if outgoing {
+		line.Fee = models.ConvertCoinsToXNS(tx.Fee)
+	}
+
+	if tx.Status() == models.TStatusReceived {
+		if outgoing {
+			balance.Sub(balance, coins(tx.Amount))
+			balance.Sub(balance, coins(tx.Fee))
+		}
+		if incoming && len(tx.DepositToReference) == 0 {
+			balance.Add(balance, coins(tx.Amount))
+		}
+	}
+	line.Balance = coinsToXNS(balance)
+	return line
+}
+
+type statementOutput struct {
+	csv  *csv.Writer
+	json *json.Encoder
+}
+
+func statementWriter(w io.Writer, format string) statementOutput {
+	if format == statementFormatCSV {
+		return statementOutput{csv: csv.NewWriter(w)}
+	}
+	return statementOutput{json: json.NewEncoder(w)}
+}
+
+func (o statementOutput) line(l StatementLine) error {
+	if o.csv != nil {
+		return o.csv.Write(l.csv())
+	}
+	return o.json.Encode(l)
+}
+
+func (o statementOutput) flush() error {
+	if o.csv != nil {
+		o.csv.Flush()
+		return o.csv.Error()
+	}
+	return nil
+}
+
+func coins(s string) *big.Int {
+	v, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return new(big.Int)
+	}
+	return v
+}
+
+func coinsToXNS(v *big.Int) string {
+	if v.Sign() < 0 {
+		return "-" + models.ConvertCoinsToXNS(new(big.Int).Neg(v).String())
+	}
+	return models.ConvertCoinsToXNS(v.String())
+}
@@ -0,0 +1,408 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+	"github.com/go-pg/pg"
+	"github.com/insolar/insolar/insolar"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"golang.org/x/net/websocket"
+
+	"github.com/insolar/observer/component"
+	"github.com/insolar/observer/internal/dbconn"
+	"github.com/insolar/observer/internal/models"
+)
+
+const (
+	StreamPath = "/api/stream/transactions"
+
+	// Notified by simple_transactions trigger, payload is hex encoded tx_id.
+	transactionsChannel = "observer_transactions"
+
+	streamBuffer       = 256
+	streamPingInterval = 15 * time.Second
+	streamReplayBatch  = 1000
+)
+
+var errStreamOverflow = errors.New("subscriber is too slow, stream closed")
+
+// Stream pushes transactions to SSE and WebSocket subscribers when they are registered and when their status changes.
+// Changes come from Postgres LISTEN/NOTIFY on the primary db.
+type Stream struct {
+	db  *dbconn.Pool
+	log insolar.Logger
+
+	mu   sync.Mutex
+	subs map[*subscriber]struct{}
+}
+
+type subscriber struct {
+	filter streamFilter
+	events chan models.Transaction
+	// Closed by the stream if events overflow.
+	dropped chan struct{}
+}
+
+type streamFilter struct {
+	member *insolar.Reference
+	txType string
+	status string
+}
+
+func (f streamFilter) match(tx models.Transaction) bool {
+	if f.member != nil &&
+		!bytes.Equal(tx.MemberFromReference, f.member.Bytes()) && !bytes.Equal(tx.MemberToReference, f.member.Bytes()) {
+		return false
+	}
+	if f.txType != "" && string(tx.Type) != f.txType {
+		return false
+	}
+	if f.status != "" && string(tx.Status()) != f.status {
+		return false
+	}
+	return true
+}
+
+func NewStream(db *dbconn.Pool, log insolar.Logger) *Stream {
+	return &Stream{db: db, log: log, subs: make(map[*subscriber]struct{})}
+}
+
+func RegisterStreamHandler(router runtime.EchoRouter, s *Stream) {
+	router.GET(StreamPath, s.Transactions)
+}
+
+// Run listens for transaction notifications until ctx is done.
+func (s *Stream) Run(ctx context.Context) {
+	ln := s.db.Primary().Listen(transactionsChannel)
+	go func() {
+		<-ctx.Done()
+		if err := ln.Close(); err != nil {
+			s.log.Error(errors.Wrap(err, "failed to close transactions listener"))
+		}
+	}()
+	// Channel reconnects by itself and is closed by ln.Close.
+	for n := range ln.Channel() {
+		s.dispatch(ctx, n.Payload)
+	}
+}
+
+func (s *Stream) dispatch(ctx context.Context, payload string) {
+	s.mu.Lock()
+	empty := len(s.subs) == 0
+	s.mu.Unlock()
+	if empty {
+		return
+	}
+
+	txID, err := hex.DecodeString(payload)
+	if err != nil {
+		s.log.Error(errors.Wrapf(err, "invalid transaction notification %q", payload))
+		return
+	}
+	// Replicas may not have the change yet.
+	tx, err := component.GetTx(ctx, s.db.Primary(), txID)
+	if err != nil {
+		// Not registered transactions aren't shown by API.
+		if err != component.ErrTxNotFound {
+			s.log.Error(err)
+		}
+		return
+	}
+	txs := []models.Transaction{*tx}
+	if err := component.SetPulseDates(ctx, s.db.Primary(), txs); err != nil {
+		s.log.Error(err)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for sub := range s.subs {
+		if !sub.filter.match(txs[0]) {
+			continue
+		}
+		select {
+		case sub.events <- txs[0]:
+		default:
+			delete(s.subs, sub)
+			close(sub.dropped)
+		}
+	}
+}
+
+func (s *Stream) subscribe(filter streamFilter) *subscriber {
+	sub := &subscriber{
+		filter:  filter,
+		events:  make(chan models.Transaction, streamBuffer),
+		dropped: make(chan struct{}),
+	}
+	s.mu.Lock()
+	s.subs[sub] = struct{}{}
+	s.mu.Unlock()
+	return sub
+}
+
+func (s *Stream) unsubscribe(sub *subscriber) {
+	s.mu.Lock()
+	delete(s.subs, sub)
+	s.mu.Unlock()
+}
+
+// Transactions streams transactions matching `member`, `type` and `status` query parameters.
+// Sent transactions have the index of their change, transactions changed after `index` (or SSE `Last-Event-ID`)
+// are sent first, so clients can resume after reconnect.
+// WebSocket is used if the request asks for upgrade, Server-Sent Events otherwise.
+func (s *Stream) Transactions(ctx echo.Context) error {
+	filter, errMsg := parseStreamFilter(ctx)
+	if errMsg != nil {
+		return ctx.JSON(http.StatusBadRequest, *errMsg)
+	}
+	index := ctx.QueryParam("index")
+	if index == "" {
+		index = ctx.Request().Header.Get("Last-Event-ID")
+	}
+	after, err := s.after(ctx.Request().Context(), index)
+	if err != nil {
+		if _, ok := err.(*FilterError); ok {
+			return ctx.JSON(http.StatusBadRequest, NewSingleMessageError(err.Error()))
+		}
+		s.log.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, struct{}{})
+	}
+
+	if strings.EqualFold(ctx.Request().Header.Get(echo.HeaderUpgrade), "websocket") {
+		server := websocket.Server{
+			// API is public, any origin is fine.
+			Handshake: func(*websocket.Config, *http.Request) error { return nil },
+			Handler: func(ws *websocket.Conn) {
+				closed := make(chan struct{})
+				go func() {
+					_, _ = io.Copy(ioutil.Discard, ws)
+					close(closed)
+				}()
+				send := func(tx models.Transaction) error {
+					return websocket.JSON.Send(ws, TxToAPITx(tx, models.TxIndexTypeChange))
+				}
+				// Closed connection is noticed by the reader above.
+				ping := func() error { return nil }
+				if err := s.serve(ctx.Request().Context(), filter, after, send, ping, closed); err != nil {
+					s.log.Debug(errors.Wrap(err, "websocket stream closed"))
+				}
+			},
+		}
+		server.ServeHTTP(ctx.Response(), ctx.Request())
+		return nil
+	}
+
+	res := ctx.Response()
+	res.Header().Set(echo.HeaderContentType, "text/event-stream")
+	res.Header().Set("Cache-Control", "no-cache")
+	res.Header().Set("Connection", "keep-alive")
+	res.WriteHeader(http.StatusOK)
+	res.Flush()
+
+	send := func(tx models.Transaction) error {
+		data, err := json.Marshal(TxToAPITx(tx, models.TxIndexTypeChange))
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(res, "id: %s\nevent: %s\ndata: %s\n\n", tx.Index(models.TxIndexTypeChange), tx.Status(), data)
+		res.Flush()
+		return err
+	}
+	ping := func() error {
+		_, err := io.WriteString(res, ": ping\n\n")
+		res.Flush()
+		return err
+	}
+	if err := s.serve(ctx.Request().Context(), filter, after, send, ping, nil); err != nil {
+		s.log.Debug(errors.Wrap(err, "event stream closed"))
+	}
+	return nil
+}
+
+// Watch is Transactions for other transports, it sends transactions with send until ctx is done or send fails.
+// Filter and index are the same as query parameters, *FilterError is returned if they are invalid.
+func (s *Stream) Watch(ctx context.Context, member, txType, status, index string, send func(models.Transaction) error) error {
+	filter, errMsg := newStreamFilter(member, txType, status)
+	if errMsg != nil {
+		return &FilterError{Messages: errMsg.Error}
+	}
+	after, err := s.after(ctx, index)
+	if err != nil {
+		return err
+	}
+
+	// Transports have their own keepalive.
+	ping := func() error { return nil }
+	return s.serve(ctx, filter, after, send, ping, nil)
+}
+
+// FilterError is returned by Watch for invalid parameters.
+type FilterError struct {
+	Messages []string
+}
+
+func (e *FilterError) Error() string {
+	return strings.Join(e.Messages, " ")
+}
+
+// after parses the change index to resume after, nil means not to replay.
+// Index of the transactions API, which the stream used before migration 27, is resolved to the position before
+// the first change of transactions registered after it.
+func (s *Stream) after(ctx context.Context, index string) (*int64, error) {
+	if index == "" {
+		return nil, nil
+	}
+	if !strings.Contains(index, ":") {
+		position, err := strconv.ParseInt(index, 10, 64)
+		if err != nil || position < 0 {
+			return nil, &FilterError{Messages: []string{"Query parameter 'index' should be an index of a streamed transaction."}}
+		}
+		return &position, nil
+	}
+	pulse, record, err := checkIndex(index)
+	if err != nil {
+		return nil, &FilterError{Messages: []string{err.Error()}}
+	}
+	var position int64
+	_, err = s.db.Primary().QueryOneContext(ctx, pg.Scan(&position), `
+		select coalesce(
+			(select min(change_seq) - 1 from simple_transactions
+				where status_registered = true and pulse_record > array[?,?]::bigint[]),
+			(select max(change_seq) from simple_transactions),
+			0)`,
+		pulse, record)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to resolve transaction index")
+	}
+	return &position, nil
+}
+
+// serve replays transactions after the position before subscribing, events of a long replay would overflow
+// the subscriber. The last replayed batch is replayed again after subscribing to not miss changes made meanwhile,
+// so a transaction could be sent twice.
+func (s *Stream) serve(
+	ctx context.Context, filter streamFilter, after *int64,
+	send func(models.Transaction) error, ping func() error, closed <-chan struct{},
+) error {
+	if after != nil {
+		last, err := s.replay(ctx, filter, *after, send)
+		if err != nil {
+			return err
+		}
+		after = &last
+	}
+	sub := s.subscribe(filter)
+	defer s.unsubscribe(sub)
+	if after != nil {
+		if _, err := s.replay(ctx, filter, *after, send); err != nil {
+			return err
+		}
+	}
+
+	ticker := time.NewTicker(streamPingInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-closed:
+			return nil
+		case <-sub.dropped:
+			return errStreamOverflow
+		case tx := <-sub.events:
+			if err := send(tx); err != nil {
+				return err
+			}
+		case <-ticker.C:
+			if err := ping(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// replay sends transactions changed after the position in the order of changes, older changes of a transaction
+// are replaced by the last one. It returns the position the last batch was selected after.
+func (s *Stream) replay(
+	ctx context.Context, filter streamFilter, after int64, send func(models.Transaction) error,
+) (int64, error) {
+	for {
+		var txs []models.Transaction
+		// Replicas may lag behind notifications, which come from the primary.
+		query := s.db.Primary().ModelContext(ctx, &txs).
+			Where("status_registered = true").
+			Where("change_seq > ?", after)
+		if filter.member != nil {
+			query, _ = component.FilterByMemberReferenceAndDirection(query, filter.member, nil)
+		}
+		if filter.txType != "" {
+			query = query.Where("type = ?", filter.txType)
+		}
+		if err := query.Order("change_seq ASC").Limit(streamReplayBatch).Select(); err != nil {
+			return after, errors.Wrap(err, "failed to select transactions to replay")
+		}
+		if err := component.SetPulseDates(ctx, s.db.Primary(), txs); err != nil {
+			return after, err
+		}
+		for _, tx := range txs {
+			if !filter.match(tx) {
+				continue
+			}
+			if err := send(tx); err != nil {
+				return after, err
+			}
+		}
+		if len(txs) < streamReplayBatch {
+			return after, nil
+		}
+		after = txs[len(txs)-1].ChangeSeq
+	}
+}
+
+func parseStreamFilter(ctx echo.Context) (streamFilter, *ErrorMessage) {
+	return newStreamFilter(ctx.QueryParam("member"), ctx.QueryParam("type"), ctx.QueryParam("status"))
+}
+
+func newStreamFilter(member, txType, status string) (streamFilter, *ErrorMessage) {
+	var filter streamFilter
+	var errorMsg ErrorMessage
+	if member != "" {
+		ref, err := insolar.NewReferenceFromString(strings.TrimSpace(member))
+		if err != nil {
+			errorMsg.Error = append(errorMsg.Error, "Query parameter 'member' should be a member reference.")
+		}
+		filter.member = ref
+	}
+	switch models.TransactionType(txType) {
+	case "", models.TTypeTransfer, models.TTypeMigration, models.TTypeRelease, models.TTypeAllocation, models.TTypeBurn:
+		filter.txType = txType
+	default:
+		errorMsg.Error = append(errorMsg.Error,
+			"Query parameter 'type' should be 'transfer', 'migration', 'release', 'allocation' or 'burn'.")
+	}
+	switch models.TransactionStatus(status) {
+	case "", models.TStatusRegistered, models.TStatusSent, models.TStatusReceived, models.TStatusFailed:
+		filter.status = status
+	default:
+		errorMsg.Error = append(errorMsg.Error,
+			"Query parameter 'status' should be 'registered', 'sent', 'received' or 'failed'.")
+	}
+	if len(errorMsg.Error) > 0 {
+		return filter, &errorMsg
+	}
+	return filter, nil
+}
@@ -0,0 +1,310 @@
+package webhooks
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+	"github.com/go-pg/pg"
+	"github.com/insolar/insolar/insolar"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/insolar/observer/internal/app/api"
+	"github.com/insolar/observer/internal/models"
+)
+
+const (
+	defaultDeliveriesLimit = 100
+	maxDeliveriesLimit     = 1000
+)
+
+// RegisterHandlers adds admin endpoints if webhooks are enabled, auth is s.Authorize unless API auth is used.
+func RegisterHandlers(router runtime.EchoRouter, s *Service, auth echo.MiddlewareFunc) {
+	if !s.Enabled() {
+		return
+	}
+	router.POST("/admin/webhooks", s.Create, auth)
+	router.GET("/admin/webhooks", s.List, auth)
+	router.GET("/admin/webhooks/deliveries", s.Deliveries, auth)
+	router.POST("/admin/webhooks/deliveries/:id/redeliver", s.Redeliver, auth)
+	router.GET("/admin/webhooks/:id", s.Get, auth)
+	router.DELETE("/admin/webhooks/:id", s.Delete, auth)
+}
+
+// Authorize requires `Authorization: Bearer <admin token>`.
+func (s *Service) Authorize(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		token := strings.TrimPrefix(ctx.Request().Header.Get(echo.HeaderAuthorization), "Bearer ")
+		if subtle.ConstantTimeCompare([]byte(token), []byte(s.cfg.AdminToken)) != 1 {
+			return ctx.JSON(http.StatusUnauthorized, api.NewSingleMessageError("Admin token is invalid."))
+		}
+		return next(ctx)
+	}
+}
+
+// WebhookRequest subscribes the URL to events of the members.
+// Empty types and statuses match any, statuses are the final ones: received or failed.
+type WebhookRequest struct {
+	URL string `json:"url"`
+	// Key to sign events, generated if empty.
+	Secret         string   `json:"secret"`
+	Members        []string `json:"members"`
+	Types          []string `json:"types"`
+	Statuses       []string `json:"statuses"`
+	BalanceChanges bool     `json:"balanceChanges"`
+}
+
+type WebhookResponse struct {
+	ID             int64    `json:"id"`
+	URL            string   `json:"url"`
+	Secret         string   `json:"secret,omitempty"`
+	Members        []string `json:"members"`
+	Types          []string `json:"types"`
+	Statuses       []string `json:"statuses"`
+	BalanceChanges bool     `json:"balanceChanges"`
+	Created        int64    `json:"created"`
+}
+
+type DeliveryResponse struct {
+	ID          int64           `json:"id"`
+	WebhookID   int64           `json:"webhookId"`
+	Event       string          `json:"event"`
+	Status      string          `json:"status"`
+	Attempts    int             `json:"attempts"`
+	NextAttempt int64           `json:"nextAttempt"`
+	LastError   string          `json:"lastError,omitempty"`
+	Created     int64           `json:"created"`
+	Payload     json.RawMessage `json:"payload"`
+}
+
+func webhookResponse(hook models.Webhook, withSecret bool) WebhookResponse {
+	res := WebhookResponse{
+		ID:             hook.ID,
+		URL:            hook.URL,
+		Members:        make([]string, 0, len(hook.MemberRefs)),
+		Types:          hook.Types,
+		Statuses:       hook.Statuses,
+		BalanceChanges: hook.BalanceChanges,
+		Created:        hook.Created.Unix(),
+	}
+	if withSecret {
+		res.Secret = hook.Secret
+	}
+	for _, ref := range hook.MemberRefs {
+		res.Members = append(res.Members, insolar.NewReferenceFromBytes(ref).String())
+	}
+	if res.Types == nil {
+		res.Types = []string{}
+	}
+	if res.Statuses == nil {
+		res.Statuses = []string{}
+	}
+	return res
+}
+
+// Create registers a webhook, the response is the only place the generated secret is shown.
+func (s *Service) Create(ctx echo.Context) error {
+	req := WebhookRequest{}
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, api.NewSingleMessageError("Request body should be a webhook."))
+	}
+	hook, errMsg := checkWebhook(req)
+	if errMsg != nil {
+		return ctx.JSON(http.StatusBadRequest, *errMsg)
+	}
+	if hook.Secret == "" {
+		secret := make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			s.log.Error(errors.Wrap(err, "failed to generate webhook secret"))
+			return ctx.JSON(http.StatusInternalServerError, struct{}{})
+		}
+		hook.Secret = hex.EncodeToString(secret)
+	}
+	if _, err := s.db.Primary().ModelContext(ctx.Request().Context(), &hook).Returning("*").Insert(); err != nil {
+		s.log.Error(errors.Wrap(err, "failed to insert webhook"))
+		return ctx.JSON(http.StatusInternalServerError, struct{}{})
+	}
+	return ctx.JSON(http.StatusCreated, webhookResponse(hook, true))
+}
+
+func checkWebhook(req WebhookRequest) (models.Webhook, *api.ErrorMessage) {
+	var errorMsg api.ErrorMessage
+	hook := models.Webhook{
+		URL:            strings.TrimSpace(req.URL),
+		Secret:         req.Secret,
+		Types:          req.Types,
+		Statuses:       req.Statuses,
+		BalanceChanges: req.BalanceChanges,
+	}
+	if u, err := url.Parse(hook.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		errorMsg.Error = append(errorMsg.Error, "Field 'url' should be an http or https URL.")
+	}
+	if len(req.Members) == 0 {
+		errorMsg.Error = append(errorMsg.Error, "Field 'members' should contain at least one member reference.")
+	}
+	for _, member := range req.Members {
+		ref, err := insolar.NewReferenceFromString(strings.TrimSpace(member))
+		if err != nil {
+			errorMsg.Error = append(errorMsg.Error, "Field 'members' should contain member references.")
+			break
+		}
+		hook.MemberRefs = append(hook.MemberRefs, ref.Bytes())
+	}
+	for _, t := range req.Types {
+		switch models.TransactionType(t) {
+		case models.TTypeTransfer, models.TTypeMigration, models.TTypeRelease, models.TTypeAllocation, models.TTypeBurn:
+		default:
+			errorMsg.Error = append(errorMsg.Error,
+				"Field 'types' should contain 'transfer', 'migration', 'release', 'allocation' or 'burn'.")
+		}
+	}
+	for _, st := range req.Statuses {
+		switch models.TransactionStatus(st) {
+		case models.TStatusReceived, models.TStatusFailed:
+		default:
+			errorMsg.Error = append(errorMsg.Error, "Field 'statuses' should contain 'received' or 'failed'.")
+		}
+	}
+	if len(errorMsg.Error) > 0 {
+		return hook, &errorMsg
+	}
+	return hook, nil
+}
+
+func (s *Service) List(ctx echo.Context) error {
+	var hooks []models.Webhook
+	err := s.db.Primary().ModelContext(ctx.Request().Context(), &hooks).Order("id ASC").Select()
+	if err != nil {
+		s.log.Error(errors.Wrap(err, "failed to select webhooks"))
+		return ctx.JSON(http.StatusInternalServerError, struct{}{})
+	}
+	res := make([]WebhookResponse, 0, len(hooks))
+	for _, hook := range hooks {
+		res = append(res, webhookResponse(hook, false))
+	}
+	return ctx.JSON(http.StatusOK, res)
+}
+
+func (s *Service) Get(ctx echo.Context) error {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, api.NewSingleMessageError("Path parameter 'id' should be a number."))
+	}
+	hook := models.Webhook{}
+	err = s.db.Primary().ModelContext(ctx.Request().Context(), &hook).Where("id = ?", id).Select()
+	if err != nil {
+		if err == pg.ErrNoRows {
+			return ctx.NoContent(http.StatusNotFound)
+		}
+		s.log.Error(errors.Wrap(err, "failed to select webhook"))
+		return ctx.JSON(http.StatusInternalServerError, struct{}{})
+	}
+	return ctx.JSON(http.StatusOK, webhookResponse(hook, false))
+}
+
+// Delete removes the webhook with all its deliveries.
+func (s *Service) Delete(ctx echo.Context) error {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, api.NewSingleMessageError("Path parameter 'id' should be a number."))
+	}
+	res, err := s.db.Primary().ModelContext(ctx.Request().Context(), &models.Webhook{}).Where("id = ?", id).Delete()
+	if err != nil {
+		s.log.Error(errors.Wrap(err, "failed to delete webhook"))
+		return ctx.JSON(http.StatusInternalServerError, struct{}{})
+	}
+	if res.RowsAffected() == 0 {
+		return ctx.NoContent(http.StatusNotFound)
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// Deliveries lists deliveries by `status` (dead by default, i.e. the dead-letter list) and optional `webhook` id,
+// newest first.
+func (s *Service) Deliveries(ctx echo.Context) error {
+	var errorMsg api.ErrorMessage
+	status := models.WebhookDeliveryStatus(ctx.QueryParam("status"))
+	switch status {
+	case "":
+		status = models.WebhookDeliveryDead
+	case models.WebhookDeliveryPending, models.WebhookDeliveryDelivered, models.WebhookDeliveryDead:
+	default:
+		errorMsg.Error = append(errorMsg.Error, "Query parameter 'status' should be 'pending', 'delivered' or 'dead'.")
+	}
+	limit := defaultDeliveriesLimit
+	if l := ctx.QueryParam("limit"); l != "" {
+		var err error
+		limit, err = strconv.Atoi(l)
+		if err != nil || limit < 1 || limit > maxDeliveriesLimit {
+			errorMsg.Error = append(errorMsg.Error, "Query parameter 'limit' should be between 1 and 1000.")
+		}
+	}
+	var webhookID int64
+	if w := ctx.QueryParam("webhook"); w != "" {
+		var err error
+		webhookID, err = strconv.ParseInt(w, 10, 64)
+		if err != nil {
+			errorMsg.Error = append(errorMsg.Error, "Query parameter 'webhook' should be a number.")
+		}
+	}
+	if len(errorMsg.Error) > 0 {
+		return ctx.JSON(http.StatusBadRequest, errorMsg)
+	}
+
+	var deliveries []models.WebhookDelivery
+	query := s.db.Primary().ModelContext(ctx.Request().Context(), &deliveries).Where("status = ?", status)
+	if webhookID != 0 {
+		query = query.Where("webhook_id = ?", webhookID)
+	}
+	if err := query.Order("id DESC").Limit(limit).Select(); err != nil {
+		s.log.Error(errors.Wrap(err, "failed to select webhook deliveries"))
+		return ctx.JSON(http.StatusInternalServerError, struct{}{})
+	}
+	res := make([]DeliveryResponse, 0, len(deliveries))
+	for _, d := range deliveries {
+		res = append(res, DeliveryResponse{
+			ID:          d.ID,
+			WebhookID:   d.WebhookID,
+			Event:       d.Event,
+			Status:      string(d.Status),
+			Attempts:    d.Attempts,
+			NextAttempt: d.NextAttempt.Unix(),
+			LastError:   d.LastError,
+			Created:     d.Created.Unix(),
+			Payload:     json.RawMessage(d.Payload),
+		})
+	}
+	return ctx.JSON(http.StatusOK, res)
+}
+
+// Redeliver queues the delivery again with a fresh attempts budget, e.g. after the subscriber is fixed.
+func (s *Service) Redeliver(ctx echo.Context) error {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, api.NewSingleMessageError("Path parameter 'id' should be a number."))
+	}
+	res, err := s.db.Primary().ExecContext(ctx.Request().Context(), `
+		update webhook_deliveries
+		set status = ?0, attempts = 0, next_attempt = now(), last_error = null
+		where id = ?1`,
+		models.WebhookDeliveryPending, id)
+	if err != nil {
+		s.log.Error(errors.Wrap(err, "failed to redeliver webhook event"))
+		return ctx.JSON(http.StatusInternalServerError, struct{}{})
+	}
+	if res.RowsAffected() == 0 {
+		return ctx.NoContent(http.StatusNotFound)
+	}
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+	return ctx.NoContent(http.StatusAccepted)
+}
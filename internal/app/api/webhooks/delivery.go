@@ -0,0 +1,162 @@
+package webhooks
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-pg/pg"
+	"github.com/pkg/errors"
+
+	"github.com/insolar/observer/configuration"
+	"github.com/insolar/observer/internal/models"
+)
+
+const (
+	HeaderSignature = "X-Observer-Signature"
+	HeaderEvent     = "X-Observer-Event"
+	HeaderDelivery  = "X-Observer-Delivery"
+)
+
+// Sign returns HMAC-SHA256 of the body with the webhook secret as sent in the X-Observer-Signature header.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// retryDelay is the delay after the failed attempt, it doubles with every attempt.
+func retryDelay(cfg configuration.Webhooks, attempts int) time.Duration {
+	delay := cfg.RetryInterval
+	for i := 1; i < attempts && delay < cfg.MaxRetryInterval; i++ {
+		delay *= 2
+	}
+	if delay > cfg.MaxRetryInterval {
+		delay = cfg.MaxRetryInterval
+	}
+	return delay
+}
+
+func (s *Service) deliver(ctx context.Context) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-s.wake:
+		}
+		for {
+			found, err := s.deliverNext(ctx)
+			if err != nil {
+				s.log.Error(err)
+			}
+			if !found || err != nil {
+				break
+			}
+		}
+	}
+}
+
+// leaseMargin is how long a claimed delivery is kept after the response timeout before other workers retry it.
+const leaseMargin = 30 * time.Second
+
+// deliverNext sends the oldest due delivery. It is claimed first, so other workers and instances skip it
+// while it is sent without holding a transaction, see claim.
+func (s *Service) deliverNext(ctx context.Context) (bool, error) {
+	delivery, hook, found, err := s.claim(ctx)
+	if err != nil || !found {
+		return found, err
+	}
+
+	now := time.Now()
+	if err := s.send(ctx, hook, delivery); err != nil {
+		delivery.LastError = err.Error()
+		delivery.NextAttempt = now.Add(retryDelay(s.cfg, delivery.Attempts))
+		if delivery.Attempts >= s.cfg.MaxAttempts {
+			delivery.Status = models.WebhookDeliveryDead
+		}
+	} else {
+		delivery.Status = models.WebhookDeliveryDelivered
+		delivery.Delivered = &now
+	}
+	// The lease could expire and another worker could claim the delivery meanwhile, its result wins then.
+	_, err = s.db.Primary().ModelContext(ctx, &delivery).
+		Column("status", "next_attempt", "last_error", "delivered").
+		WherePK().
+		Where("status = ?", models.WebhookDeliveryPending).
+		Where("attempts = ?", delivery.Attempts).
+		Update()
+	return true, errors.Wrap(err, "failed to update webhook delivery")
+}
+
+// claim takes the oldest due delivery and leases it: the attempt is counted and next_attempt is moved past
+// the response timeout. The delivery is retried after the lease if the instance dies while sending it.
+func (s *Service) claim(ctx context.Context) (models.WebhookDelivery, models.Webhook, bool, error) {
+	delivery := models.WebhookDelivery{}
+	hook := models.Webhook{}
+	found := false
+	lease := s.cfg.Timeout + leaseMargin
+	err := s.db.Primary().RunInTransaction(func(tx *pg.Tx) error {
+		err := tx.ModelContext(ctx, &delivery).
+			Where("status = ?", models.WebhookDeliveryPending).
+			Where("next_attempt <= now()").
+			Order("next_attempt ASC").
+			Limit(1).
+			For("UPDATE SKIP LOCKED").
+			Select()
+		if err != nil {
+			if err == pg.ErrNoRows {
+				return nil
+			}
+			return errors.Wrap(err, "failed to select webhook delivery")
+		}
+		if err := tx.ModelContext(ctx, &hook).Where("id = ?", delivery.WebhookID).Select(); err != nil {
+			return errors.Wrap(err, "failed to select webhook")
+		}
+		delivery.Attempts++
+		_, err = tx.ModelContext(ctx, &delivery).
+			Set("attempts = ?attempts").
+			Set("next_attempt = now() + ? * interval '1 millisecond'", int64(lease/time.Millisecond)).
+			WherePK().
+			Update()
+		if err != nil {
+			return errors.Wrap(err, "failed to claim webhook delivery")
+		}
+		found = true
+		return nil
+	})
+	return delivery, hook, found, err
+}
+
+// send posts the event, any 2xx response means the subscriber got it.
+func (s *Service) send(ctx context.Context, hook models.Webhook, delivery models.WebhookDelivery) error {
+	req, err := http.NewRequest(http.MethodPost, hook.URL, strings.NewReader(delivery.Payload))
+	if err != nil {
+		return errors.Wrap(err, "failed to make request")
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEvent, delivery.Event)
+	req.Header.Set(HeaderDelivery, strconv.FormatInt(delivery.ID, 10))
+	req.Header.Set(HeaderSignature, Sign(hook.Secret, []byte(delivery.Payload)))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "failed to send event")
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return errors.Errorf("unexpected response status %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package webhooks
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/observer/configuration"
+	"github.com/insolar/observer/internal/models"
+)
+
+func TestRetryDelay(t *testing.T) {
+	cfg := configuration.Webhooks{RetryInterval: time.Second, MaxRetryInterval: 5 * time.Second}
+	require.Equal(t, time.Second, retryDelay(cfg, 1))
+	require.Equal(t, 2*time.Second, retryDelay(cfg, 2))
+	require.Equal(t, 4*time.Second, retryDelay(cfg, 3))
+	require.Equal(t, 5*time.Second, retryDelay(cfg, 4))
+	require.Equal(t, 5*time.Second, retryDelay(cfg, 100))
+}
+
+func TestSend(t *testing.T) {
+	payload := `{"event":"balance","reference":"insolar:1","balance":"1"}`
+	var received *http.Request
+	var body []byte
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		body, _ = ioutil.ReadAll(r.Body)
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	s := New(nil, inslogger.FromContext(ctx), configuration.Webhooks{Timeout: time.Second})
+	hook := models.Webhook{URL: server.URL, Secret: "secret"}
+	delivery := models.WebhookDelivery{ID: 42, Event: EventBalance, Payload: payload}
+
+	err := s.send(ctx, hook, delivery)
+	require.NoError(t, err)
+	require.Equal(t, payload, string(body))
+	require.Equal(t, Sign("secret", []byte(payload)), received.Header.Get(HeaderSignature))
+	require.Equal(t, EventBalance, received.Header.Get(HeaderEvent))
+	require.Equal(t, "42", received.Header.Get(HeaderDelivery))
+	require.NotEqual(t, Sign("other", []byte(payload)), received.Header.Get(HeaderSignature))
+
+	status = http.StatusInternalServerError
+	err = s.send(ctx, hook, delivery)
+	require.Error(t, err)
+}
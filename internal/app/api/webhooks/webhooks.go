@@ -0,0 +1,287 @@
+// Package webhooks delivers finalized transactions and balance changes of watched members to subscribed URLs.
+//
+// Events come from Postgres LISTEN/NOTIFY and are stored in webhook_deliveries before sending,
+// so deliveries survive restarts and several API instances share the work. Notifications aren't kept
+// while nobody listens, so changes are also scanned from the positions saved in webhook_positions.
+package webhooks
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/go-pg/pg"
+	"github.com/insolar/insolar/insolar"
+	"github.com/pkg/errors"
+
+	"github.com/insolar/observer/component"
+	"github.com/insolar/observer/configuration"
+	"github.com/insolar/observer/internal/app/api"
+	"github.com/insolar/observer/internal/dbconn"
+	"github.com/insolar/observer/internal/models"
+)
+
+const (
+	// Notified by simple_transactions trigger, payload is hex encoded tx_id.
+	transactionsChannel = "observer_transactions"
+	// Notified by members trigger, payload is hex encoded member_ref.
+	balancesChannel = "observer_balances"
+
+	EventTransaction = "transaction"
+	EventBalance     = "balance"
+
+	pollInterval = time.Second
+
+	// Sources of webhook_positions.
+	sourceTransactions = "transactions"
+	sourceBalances     = "balances"
+
+	// Changes are scanned at startup, after the listener fails and after this long without notifications,
+	// a broken connection isn't always noticed.
+	catchUpInterval = time.Minute
+	catchUpBatch    = 1000
+)
+
+// Service enqueues events for matching webhooks and delivers them.
+type Service struct {
+	db     *dbconn.Pool
+	log    insolar.Logger
+	cfg    configuration.Webhooks
+	client *http.Client
+
+	// Wakes delivery workers up after new events are enqueued.
+	wake chan struct{}
+}
+
+func New(db *dbconn.Pool, log insolar.Logger, cfg configuration.Webhooks) *Service {
+	return &Service{
+		db:     db,
+		log:    log,
+		cfg:    cfg,
+		client: &http.Client{Timeout: cfg.Timeout},
+		wake:   make(chan struct{}, 1),
+	}
+}
+
+// Enabled reports if the admin token is set, webhooks are turned off otherwise.
+func (s *Service) Enabled() bool {
+	return s.cfg.AdminToken != ""
+}
+
+// Run listens for changes and delivers events until ctx is done.
+func (s *Service) Run(ctx context.Context) {
+	if !s.Enabled() {
+		s.log.Info("webhooks are disabled, admin token is not set")
+		return
+	}
+	workers := s.cfg.Workers
+	if workers < 1 {
+		workers = 1
+	}
+	for i := 0; i < workers; i++ {
+		go s.deliver(ctx)
+	}
+
+	ln := s.db.Primary().Listen(transactionsChannel, balancesChannel)
+	go func() {
+		<-ctx.Done()
+		if err := ln.Close(); err != nil {
+			s.log.Error(errors.Wrap(err, "failed to close webhooks listener"))
+		}
+	}()
+	// Listener reconnects by itself on the next receive, but notifications sent meanwhile are lost.
+	caughtUp := false
+	for ctx.Err() == nil {
+		if !caughtUp {
+			// Changes are scanned after LISTEN, so every change is either found or notified.
+			err := ln.Listen(transactionsChannel, balancesChannel)
+			if err == nil {
+				err = s.catchUp(ctx)
+			}
+			if err != nil {
+				s.log.Error(err)
+				s.sleep(ctx)
+				continue
+			}
+			caughtUp = true
+			s.wakeUp()
+		}
+
+		channel, payload, err := ln.ReceiveTimeout(catchUpInterval)
+		if err != nil {
+			caughtUp = false
+			if e, ok := err.(net.Error); !ok || !e.Timeout() {
+				if ctx.Err() == nil {
+					s.log.Error(errors.Wrap(err, "webhooks listener failed"))
+				}
+			}
+			continue
+		}
+		switch channel {
+		case transactionsChannel:
+			err = s.enqueueTransaction(ctx, payload)
+		case balancesChannel:
+			err = s.enqueueBalance(ctx, payload)
+		}
+		if err != nil {
+			s.log.Error(err)
+			continue
+		}
+		s.wakeUp()
+	}
+}
+
+func (s *Service) wakeUp() {
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (s *Service) sleep(ctx context.Context) {
+	select {
+	case <-ctx.Done():
+	case <-time.After(pollInterval):
+	}
+}
+
+// change is a row found by catchUp, payload is the same as in notifications.
+type change struct {
+	Seq     int64
+	Payload string
+}
+
+// catchUp enqueues events for changes after the saved positions and moves the positions.
+// Events enqueued from notifications or by other instances are skipped by their keys.
+func (s *Service) catchUp(ctx context.Context) error {
+	err := s.scan(ctx, sourceTransactions, s.enqueueTransaction, `
+		select change_seq as seq, encode(tx_id, 'hex') as payload from simple_transactions
+		where change_seq > ? order by change_seq limit ?`)
+	if err != nil {
+		return err
+	}
+	return s.scan(ctx, sourceBalances, s.enqueueBalance, `
+		select balance_seq as seq, encode(member_ref, 'hex') as payload from members
+		where balance_seq > ? order by balance_seq limit ?`)
+}
+
+func (s *Service) scan(ctx context.Context, source string, enqueue func(context.Context, string) error, query string) error {
+	var position int64
+	_, err := s.db.Primary().QueryOneContext(ctx, pg.Scan(&position),
+		`select position from webhook_positions where source = ?`, source)
+	if err != nil {
+		return errors.Wrapf(err, "failed to select webhook position of %s", source)
+	}
+	for {
+		var changes []change
+		if _, err := s.db.Primary().QueryContext(ctx, &changes, query, position, catchUpBatch); err != nil {
+			return errors.Wrapf(err, "failed to select %s to catch up", source)
+		}
+		if len(changes) == 0 {
+			return nil
+		}
+		for _, c := range changes {
+			if err := enqueue(ctx, c.Payload); err != nil {
+				return err
+			}
+		}
+		position = changes[len(changes)-1].Seq
+		_, err := s.db.Primary().ExecContext(ctx,
+			`update webhook_positions set position = greatest(position, ?) where source = ?`, position, source)
+		if err != nil {
+			return errors.Wrapf(err, "failed to save webhook position of %s", source)
+		}
+		if len(changes) < catchUpBatch {
+			return nil
+		}
+	}
+}
+
+// TransactionEvent is sent when a transaction of a watched member is finalized.
+type TransactionEvent struct {
+	Event       string      `json:"event"`
+	Transaction interface{} `json:"transaction"`
+}
+
+// BalanceEvent is sent when an account balance of a watched member changes.
+type BalanceEvent struct {
+	Event     string `json:"event"`
+	Reference string `json:"reference"`
+	Balance   string `json:"balance"`
+}
+
+func (s *Service) enqueueTransaction(ctx context.Context, payload string) error {
+	txID, err := hex.DecodeString(payload)
+	if err != nil {
+		return errors.Wrapf(err, "invalid transaction notification %q", payload)
+	}
+	// Replicas may not have the change yet.
+	tx, err := component.GetTx(ctx, s.db.Primary(), txID)
+	if err != nil {
+		if err == component.ErrTxNotFound {
+			return nil
+		}
+		return err
+	}
+	status := tx.Status()
+	if status != models.TStatusReceived && status != models.TStatusFailed {
+		return nil
+	}
+	txs := []models.Transaction{*tx}
+	if err := component.SetPulseDates(ctx, s.db.Primary(), txs); err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(TransactionEvent{
+		Event:       EventTransaction,
+		Transaction: api.TxToAPITx(txs[0], models.TxIndexTypePulseRecord),
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal transaction event")
+	}
+	_, err = s.db.Primary().ExecContext(ctx, `
+		insert into webhook_deliveries (webhook_id, event, event_key, payload)
+		select id, ?0, ?1, ?2 from webhooks
+		where member_refs && array[?3, ?4]::bytea[]
+			and (types = '{}' or ?5 = any(types))
+			and (statuses = '{}' or ?6 = any(statuses))
+		on conflict (webhook_id, event_key) do nothing`,
+		EventTransaction, EventTransaction+":"+payload+":"+string(status), string(body),
+		tx.MemberFromReference, tx.MemberToReference, string(tx.Type), string(status))
+	return errors.Wrap(err, "failed to enqueue transaction event")
+}
+
+func (s *Service) enqueueBalance(ctx context.Context, payload string) error {
+	ref, err := hex.DecodeString(payload)
+	if err != nil {
+		return errors.Wrapf(err, "invalid balance notification %q", payload)
+	}
+	member := models.Member{}
+	err = s.db.Primary().ModelContext(ctx, &member).
+		Column("balance", "account_state").
+		Where("member_ref = ?", ref).
+		Select()
+	if err != nil {
+		return errors.Wrap(err, "failed to select member balance")
+	}
+
+	body, err := json.Marshal(BalanceEvent{
+		Event:     EventBalance,
+		Reference: insolar.NewReferenceFromBytes(ref).String(),
+		Balance:   models.ConvertCoinsToXNS(member.Balance),
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal balance event")
+	}
+	// Account state changes with every balance change, so the same balance reached twice is still delivered twice.
+	_, err = s.db.Primary().ExecContext(ctx, `
+		insert into webhook_deliveries (webhook_id, event, event_key, payload)
+		select id, ?0, ?1, ?2 from webhooks
+		where balance_changes and ?3 = any(member_refs)
+		on conflict (webhook_id, event_key) do nothing`,
+		EventBalance, EventBalance+":"+payload+":"+hex.EncodeToString(member.AccountState), string(body), ref)
+	return errors.Wrap(err, "failed to enqueue balance event")
+}
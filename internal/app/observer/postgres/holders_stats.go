@@ -0,0 +1,185 @@
+package postgres
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/go-pg/pg"
+	"github.com/go-pg/pg/orm"
+	"github.com/pkg/errors"
+
+	"github.com/insolar/observer/internal/models"
+)
+
+// HoldersTopSize is the number of the biggest holders saved in a snapshot.
+const HoldersTopSize = 100
+
+// DistributionBounds are lower bounds of the balance buckets in XNS, the last bucket has no upper bound.
+var DistributionBounds = []int64{0, 1, 10, 100, 1000, 10000, 100000, 1000000, 10000000}
+
+// coinsInXNS is 10^10, see models.ConvertCoinsToXNS.
+var coinsInXNS = big.NewInt(10000000000)
+
+// holderBalancesSQL selects members with positive account plus deposit balance.
+const holderBalancesSQL = `WITH h AS (
+	SELECT m.member_ref,
+		   coalesce(nullif(m.balance, ''), '0')::numeric(24) AS account_balance,
+		   coalesce(d.balance, 0) AS deposit_balance
+	FROM members m
+			 LEFT JOIN (SELECT member_ref, sum(balance::numeric(24)) AS balance
+						FROM deposits
+						WHERE member_ref IS NOT NULL
+						GROUP BY member_ref) d ON d.member_ref = m.member_ref
+), holders AS (
+	SELECT member_ref, account_balance, deposit_balance, account_balance + deposit_balance AS balance
+	FROM h
+	WHERE account_balance + deposit_balance > 0
+)
+`
+
+// HoldersStats is a snapshot of holders, it is saved with the same created time in all tables.
+type HoldersStats struct {
+	Top          []models.HolderStats
+	Distribution models.DistributionStats
+	Buckets      []models.DistributionBucket
+}
+
+type bucketCount struct {
+	Bucket  int
+	Holders int64
+	Balance string
+}
+
+type HoldersStatsRepository struct {
+	db orm.DB
+}
+
+func NewHoldersStatsRepository(db orm.DB) *HoldersStatsRepository {
+	return &HoldersStatsRepository{db: db}
+}
+
+// CountStats computes the top holders, Gini coefficient, shares of the top holders and holders
+// per DistributionBounds bucket from balances of members and their deposits.
+func (s *HoldersStatsRepository) CountStats() (HoldersStats, error) {
+	stats := HoldersStats{Distribution: models.DistributionStats{Created: time.Now()}}
+
+	_, err := s.db.Query(&stats.Top, holderBalancesSQL+`
+		SELECT row_number() OVER (ORDER BY balance DESC, member_ref) AS rank, member_ref, account_balance, deposit_balance, balance
+		FROM holders
+		ORDER BY rank
+		LIMIT ?`, HoldersTopSize)
+	if err != nil {
+		return HoldersStats{}, errors.Wrap(err, "failed to count top holders")
+	}
+	for i := range stats.Top {
+		stats.Top[i].Created = stats.Distribution.Created
+	}
+
+	// Gini is 2 * sum(i * x_i) / (n * sum(x)) - (n + 1) / n for balances x sorted in ascending order.
+	_, err = s.db.QueryOne(&stats.Distribution, holderBalancesSQL+`
+		, ranked AS (
+			SELECT balance,
+				   row_number() OVER (ORDER BY balance) AS i,
+				   row_number() OVER (ORDER BY balance DESC) AS top
+			FROM holders
+		)
+		SELECT count(*) AS holders,
+			   coalesce(sum(balance), 0) AS total,
+			   CASE WHEN count(*) = 0 THEN 0
+					ELSE (2 * sum(i * balance) / (count(*) * sum(balance)) - (count(*) + 1)::numeric / count(*))::float8
+				   END AS gini,
+			   coalesce((sum(balance) FILTER (WHERE top <= 10) / sum(balance))::float8, 0) AS top10_share,
+			   coalesce((sum(balance) FILTER (WHERE top <= 100) / sum(balance))::float8, 0) AS top100_share
+		FROM ranked`)
+	if err != nil {
+		return HoldersStats{}, errors.Wrap(err, "failed to count balance distribution")
+	}
+
+	bounds := make([]string, 0, len(DistributionBounds))
+	for _, b := range DistributionBounds {
+		bounds = append(bounds, new(big.Int).Mul(big.NewInt(b), coinsInXNS).String())
+	}
+	var counted []bucketCount
+	_, err = s.db.Query(&counted, holderBalancesSQL+`
+		SELECT width_bucket(balance, ?::numeric[]) AS bucket, count(*) AS holders, sum(balance) AS balance
+		FROM holders
+		GROUP BY bucket`, pg.Array(bounds))
+	if err != nil {
+		return HoldersStats{}, errors.Wrap(err, "failed to count balance buckets")
+	}
+	stats.Buckets = make([]models.DistributionBucket, 0, len(bounds))
+	for _, b := range bounds {
+		stats.Buckets = append(stats.Buckets, models.DistributionBucket{
+			Created:    stats.Distribution.Created,
+			LowerBound: b,
+			Balance:    "0",
+		})
+	}
+	for _, c := range counted {
+		// Balances are positive and the first bound is zero, so buckets start from 1.
+		if c.Bucket < 1 || c.Bucket > len(bounds) {
+			continue
+		}
+		stats.Buckets[c.Bucket-1].Holders = c.Holders
+		stats.Buckets[c.Bucket-1].Balance = c.Balance
+	}
+	return stats, nil
+}
+
+// InsertStats saves the snapshot in a transaction, or in the transaction the repository was made with.
+// The distribution row is inserted last, so readers never see a partial snapshot.
+func (s *HoldersStatsRepository) InsertStats(stats HoldersStats) error {
+	if db, ok := s.db.(*pg.DB); ok {
+		return db.RunInTransaction(func(tx *pg.Tx) error {
+			return insertHoldersStats(tx, stats)
+		})
+	}
+	return insertHoldersStats(s.db, stats)
+}
+
+func insertHoldersStats(db orm.DB, stats HoldersStats) error {
+	if len(stats.Top) > 0 {
+		if err := db.Insert(&stats.Top); err != nil {
+			return errors.Wrap(err, "failed to insert top holders")
+		}
+	}
+	if len(stats.Buckets) > 0 {
+		if err := db.Insert(&stats.Buckets); err != nil {
+			return errors.Wrap(err, "failed to insert balance buckets")
+		}
+	}
+	if err := db.Insert(&stats.Distribution); err != nil {
+		return errors.Wrap(err, "failed to insert balance distribution")
+	}
+	return nil
+}
+
+// LastStats returns the last saved snapshot with at most `top` holders.
+func (s *HoldersStatsRepository) LastStats(top int) (HoldersStats, error) {
+	stats, err := s.LastDistribution()
+	if err != nil {
+		return HoldersStats{}, err
+	}
+	err = s.db.Model(&stats.Top).Where("created = ?", stats.Distribution.Created).Order("rank").Limit(top).Select()
+	if err != nil {
+		return HoldersStats{}, errors.Wrap(err, "failed to get top holders")
+	}
+	return stats, nil
+}
+
+// LastDistribution returns the last saved snapshot without top holders.
+func (s *HoldersStatsRepository) LastDistribution() (HoldersStats, error) {
+	stats := HoldersStats{}
+	err := s.db.Model(&stats.Distribution).Order("created DESC").Limit(1).Select()
+	if err == pg.ErrNoRows {
+		return HoldersStats{}, ErrNoStats
+	}
+	if err != nil {
+		return HoldersStats{}, errors.Wrap(err, "failed to get balance distribution")
+	}
+	err = s.db.Model(&stats.Buckets).Where("created = ?", stats.Distribution.Created).Order("lower_bound").Select()
+	if err != nil {
+		return HoldersStats{}, errors.Wrap(err, "failed to get balance buckets")
+	}
+	return stats, nil
+}
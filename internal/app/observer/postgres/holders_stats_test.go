@@ -0,0 +1,83 @@
+// +build !node
+
+package postgres_test
+
+import (
+	"testing"
+
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/observer/internal/app/observer/postgres"
+	"github.com/insolar/observer/internal/models"
+	"github.com/insolar/observer/internal/testutils"
+)
+
+func TestHoldersStats(t *testing.T) {
+	defer testutils.TruncateTables(t, db, []interface{}{
+		&models.Member{},
+		&models.Deposit{},
+		&models.HolderStats{},
+		&models.DistributionStats{},
+		&models.DistributionBucket{},
+	})
+	repo := postgres.NewHoldersStatsRepository(db)
+
+	_, err := repo.LastStats(10)
+	require.Equal(t, postgres.ErrNoStats, err)
+
+	rich, poor := gen.Reference().Bytes(), gen.Reference().Bytes()
+	for _, m := range []models.Member{
+		{Reference: rich, Balance: "300000000000"},
+		{Reference: poor, Balance: "100000000000"},
+		{Reference: gen.Reference().Bytes(), Balance: "0"},
+	} {
+		err = db.Insert(&m)
+		require.NoError(t, err)
+	}
+	err = db.Insert(&models.Deposit{
+		Reference:       gen.Reference().Bytes(),
+		MemberReference: poor,
+		Amount:          "500000000000",
+		Balance:         "500000000000",
+		InnerStatus:     models.DepositStatusConfirmed,
+	})
+	require.NoError(t, err)
+
+	stats, err := repo.CountStats()
+	require.NoError(t, err)
+	require.Len(t, stats.Top, 2)
+	require.Equal(t, poor, stats.Top[0].Reference)
+	require.Equal(t, 1, stats.Top[0].Rank)
+	require.Equal(t, "100000000000", stats.Top[0].AccountBalance)
+	require.Equal(t, "500000000000", stats.Top[0].DepositBalance)
+	require.Equal(t, "600000000000", stats.Top[0].Balance)
+
+	require.Equal(t, int64(2), stats.Distribution.Holders)
+	require.Equal(t, "900000000000", stats.Distribution.Total)
+	// Balances 30 and 60: 2 * (1 * 30 + 2 * 60) / (2 * 90) - 3 / 2.
+	require.InDelta(t, 1.0/6, stats.Distribution.Gini, 1e-9)
+	require.Equal(t, 1.0, stats.Distribution.Top10Share)
+
+	require.Len(t, stats.Buckets, len(postgres.DistributionBounds))
+	// Both holders have from 10 to 100 XNS.
+	require.Equal(t, "100000000000", stats.Buckets[2].LowerBound)
+	require.Equal(t, int64(2), stats.Buckets[2].Holders)
+	require.Equal(t, int64(0), stats.Buckets[1].Holders)
+
+	err = repo.InsertStats(stats)
+	require.NoError(t, err)
+
+	last, err := repo.LastStats(1)
+	require.NoError(t, err)
+	require.Len(t, last.Top, 1)
+	require.Equal(t, poor, last.Top[0].Reference)
+	require.Equal(t, int64(2), last.Distribution.Holders)
+	require.Len(t, last.Buckets, len(postgres.DistributionBounds))
+
+	distribution, err := repo.LastDistribution()
+	require.NoError(t, err)
+	require.Empty(t, distribution.Top)
+	require.Equal(t, int64(2), distribution.Distribution.Holders)
+	require.Len(t, distribution.Buckets, len(postgres.DistributionBounds))
+}
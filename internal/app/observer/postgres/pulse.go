@@ -44,6 +44,15 @@ func (s *PulseStorage) Insert(model *observer.Pulse) error {
 			Errorf("failed to insert pulse")
 		return nil
 	}
+
+	if nodes := pulseNodesSchema(model); len(nodes) > 0 {
+		_, err = s.db.Model(&nodes).
+			OnConflict("DO NOTHING").
+			Insert()
+		if err != nil {
+			return errors.Wrapf(err, "failed to insert nodes of pulse %d", model.Number)
+		}
+	}
 	return nil
 }
 
@@ -111,10 +120,25 @@ func (s *PulseStorage) GetRange(fromTimestamp, toTimestamp int64, limit int, pul
 }
 
 func pulseSchema(model *observer.Pulse) *models.Pulse {
+	records, requests := int64(model.Records), int64(model.Requests)
 	return &models.Pulse{
 		Pulse:     uint32(model.Number),
 		PulseDate: model.Timestamp,
 		Entropy:   model.Entropy[:],
 		Nodes:     uint32(len(model.Nodes)),
+		Records:   &records,
+		Requests:  &requests,
+	}
+}
+
+func pulseNodesSchema(model *observer.Pulse) []models.PulseNode {
+	nodes := make([]models.PulseNode, 0, len(model.Nodes))
+	for _, node := range model.Nodes {
+		nodes = append(nodes, models.PulseNode{
+			Pulse:     uint32(model.Number),
+			Reference: node.ID.Bytes(),
+			Role:      node.Role.String(),
+		})
 	}
+	return nodes
 }
@@ -34,11 +34,25 @@ func (s *SupplyStatsRepository) LastStats() (models.SupplyStats, error) {
 	return *lastStats, nil
 }
 
+// CountStats sums balances of members and deposits into total and splits it by the state of deposits.
+// Deposits on hold are locked. Deposits in vesting keep the part that isn't released by the schedule
+// (see api.ReleaseSchedule) as vesting. The rest is circulating. Burned is the last burned balance.
 func (s *SupplyStatsRepository) CountStats() (models.SupplyStats, error) {
-	sql := fmt.Sprintf(`SELECT (SELECT coalesce(sum(balance::numeric(24)), 0) FROM members) + 
-								(SELECT coalesce(sum(balance::numeric(24)), 0) FROM deposits) AS total;`)
+	sql := `WITH d AS (
+				SELECT balance::numeric(24) AS balance, coalesce(nullif(amount, ''), '0')::numeric(24) AS amount,
+					   hold_release_date AS hold, vesting, vesting_step AS step
+				FROM deposits
+			), s AS (
+				SELECT (SELECT coalesce(sum(balance::numeric(24)), 0) FROM members) +
+					   (SELECT coalesce(sum(balance), 0) FROM d) AS total,
+					   (SELECT coalesce(sum(balance), 0) FROM d WHERE ?0 < hold) AS locked,
+					   (SELECT coalesce(sum(least(balance, amount - trunc(amount * ((?0 - hold) / step) / ((vesting + step - 1) / step)))), 0)
+						FROM d WHERE vesting > 0 AND step > 0 AND ?0 >= hold AND ?0 < hold + vesting) AS vesting,
+					   coalesce((SELECT nullif(balance, '')::numeric(24) FROM burned_balance ORDER BY id DESC LIMIT 1), 0) AS burned
+			)
+			SELECT total, total - locked - vesting AS circulating, locked, vesting, burned FROM s;`
 	stats := models.SupplyStats{}
-	_, err := s.db.Query(&stats, sql)
+	_, err := s.db.Query(&stats, sql, time.Now().Unix())
 	if err != nil {
 		return models.SupplyStats{}, errors.Wrap(err, "failed request to db")
 	}
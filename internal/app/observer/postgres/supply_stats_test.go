@@ -159,4 +159,48 @@ func TestSupplyStats(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, "400", stats.Total)
 	})
+
+	t.Run("breakdown", func(t *testing.T) {
+		defer testutils.TruncateTables(t, db, []interface{}{
+			&models.Member{},
+			&models.SupplyStats{},
+			&models.Deposit{},
+			&models.BurnedBalance{},
+		})
+		now := time.Now().Unix()
+		err := db.Insert(&models.Member{Reference: gen.Reference().Bytes(), Balance: "200"})
+		require.NoError(t, err)
+		err = db.Insert(&models.BurnedBalance{ID: 1, Balance: "30"})
+		require.NoError(t, err)
+
+		deposits := []models.Deposit{
+			// On hold.
+			{HoldReleaseDate: now + 1000, Amount: "100", Balance: "100"},
+			// The first of 10 steps is released.
+			{HoldReleaseDate: now - 100, Vesting: 1000, VestingStep: 100, Amount: "1000", Balance: "1000"},
+			// Released, only the balance is left.
+			{HoldReleaseDate: now - 100, Amount: "100", Balance: "50"},
+		}
+		for _, d := range deposits {
+			d.Reference = gen.Reference().Bytes()
+			d.InnerStatus = models.DepositStatusConfirmed
+			err = db.Insert(&d)
+			require.NoError(t, err)
+		}
+
+		stats, err := supplyStatsRepository.CountStats()
+		require.NoError(t, err)
+		require.Equal(t, "1350", stats.Total)
+		require.Equal(t, "350", stats.Circulating)
+		require.Equal(t, "100", stats.Locked)
+		require.Equal(t, "900", stats.Vesting)
+		require.Equal(t, "30", stats.Burned)
+
+		err = supplyStatsRepository.InsertStats(stats)
+		require.NoError(t, err)
+		last, err := supplyStatsRepository.LastStats()
+		require.NoError(t, err)
+		require.Equal(t, "1350", last.Total)
+		require.Equal(t, "350", last.Circulating)
+	})
 }
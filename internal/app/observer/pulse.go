@@ -11,6 +11,10 @@ type Pulse struct {
 	Entropy   insolar.Entropy
 	Timestamp int64
 	Nodes     []insolar.Node
+
+	// Records and Requests are counted in the fetched batch of the pulse.
+	Records  int
+	Requests int
 }
 
 type PulseStorage interface {
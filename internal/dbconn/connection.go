@@ -1,6 +1,13 @@
 package dbconn
 
 import (
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"net"
+	"strings"
+	"time"
+
 	"github.com/go-pg/pg"
 	"github.com/pkg/errors"
 
@@ -8,6 +15,15 @@ import (
 )
 
 func Connect(cfg configuration.DB) (*pg.DB, error) {
+	opt, err := Options(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return pg.Connect(opt), nil
+}
+
+// Options makes go-pg options from cfg.URL and the rest of cfg settings, the latter take precedence.
+func Options(cfg configuration.DB) (*pg.Options, error) {
 	opt, err := pg.ParseURL(cfg.URL)
 	if err != nil {
 		// pg.ParseURL uses standard url.Parse
@@ -16,5 +32,83 @@ func Connect(cfg configuration.DB) (*pg.DB, error) {
 		return nil, errors.New("failed to parse cfg.DB.URL")
 	}
 	opt.PoolSize = cfg.PoolSize
-	return pg.Connect(opt), nil
+	opt.IdleTimeout = cfg.IdleTimeout
+	opt.MaxConnAge = cfg.MaxConnAge
+	if cfg.ApplicationName != "" {
+		opt.ApplicationName = cfg.ApplicationName
+	}
+
+	password, err := password(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if password != "" {
+		opt.Password = password
+	}
+
+	if cfg.TLS.Enabled {
+		opt.TLSConfig, err = tlsConfig(cfg.TLS, opt.Addr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if cfg.StatementTimeout > 0 {
+		timeout := int64(cfg.StatementTimeout / time.Millisecond)
+		opt.OnConnect = func(conn *pg.Conn) error {
+			_, err := conn.Exec("SET statement_timeout = ?", timeout)
+			return errors.Wrap(err, "failed to set statement_timeout")
+		}
+	}
+	return opt, nil
+}
+
+// password returns password from cfg.PasswordFile or cfg.Password, empty string means the one from URL is used.
+func password(cfg configuration.DB) (string, error) {
+	if cfg.PasswordFile == "" {
+		return cfg.Password, nil
+	}
+	data, err := ioutil.ReadFile(cfg.PasswordFile)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to read db password file")
+	}
+	return strings.TrimRight(string(data), "\r\n"), nil
+}
+
+func tlsConfig(cfg configuration.TLS, addr string) (*tls.Config, error) {
+	serverName := cfg.ServerName
+	if serverName == "" {
+		host, _, err := net.SplitHostPort(addr)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get db host")
+		}
+		serverName = host
+	}
+
+	// nolint:gosec
+	conf := &tls.Config{
+		ServerName:         serverName,
+		InsecureSkipVerify: cfg.InsecureSkipVerify,
+	}
+
+	if cfg.CAFile != "" {
+		ca, err := ioutil.ReadFile(cfg.CAFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read db CA file")
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(ca) {
+			return nil, errors.Errorf("no certificates found in %s", cfg.CAFile)
+		}
+		conf.RootCAs = pool
+	}
+
+	if cfg.CertFile != "" || cfg.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to load db client certificate")
+		}
+		conf.Certificates = []tls.Certificate{cert}
+	}
+	return conf, nil
 }
@@ -0,0 +1,60 @@
+package dbconn
+
+import (
+	"github.com/go-pg/pg"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// StatsCollector exports go-pg connection pool stats to prometheus.
+type StatsCollector struct {
+	dbs map[string]*pg.DB
+
+	hits       *prometheus.Desc
+	misses     *prometheus.Desc
+	timeouts   *prometheus.Desc
+	totalConns *prometheus.Desc
+	idleConns  *prometheus.Desc
+	staleConns *prometheus.Desc
+}
+
+// NewStatsCollector makes collector for the pools, map key is used as "db" label.
+func NewStatsCollector(dbs map[string]*pg.DB) *StatsCollector {
+	labels := []string{"db"}
+	return &StatsCollector{
+		dbs: dbs,
+
+		hits: prometheus.NewDesc("observer_db_pool_hits_total",
+			"Number of times free connection was found in the pool.", labels, nil),
+		misses: prometheus.NewDesc("observer_db_pool_misses_total",
+			"Number of times free connection was not found in the pool.", labels, nil),
+		timeouts: prometheus.NewDesc("observer_db_pool_timeouts_total",
+			"Number of times a wait timeout occurred.", labels, nil),
+		totalConns: prometheus.NewDesc("observer_db_pool_conns",
+			"Number of total connections in the pool.", labels, nil),
+		idleConns: prometheus.NewDesc("observer_db_pool_idle_conns",
+			"Number of idle connections in the pool.", labels, nil),
+		staleConns: prometheus.NewDesc("observer_db_pool_stale_conns_total",
+			"Number of stale connections removed from the pool.", labels, nil),
+	}
+}
+
+func (c *StatsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.hits
+	ch <- c.misses
+	ch <- c.timeouts
+	ch <- c.totalConns
+	ch <- c.idleConns
+	ch <- c.staleConns
+}
+
+func (c *StatsCollector) Collect(ch chan<- prometheus.Metric) {
+	for name, db := range c.dbs {
+		stats := db.PoolStats()
+		ch <- prometheus.MustNewConstMetric(c.hits, prometheus.CounterValue, float64(stats.Hits), name)
+		ch <- prometheus.MustNewConstMetric(c.misses, prometheus.CounterValue, float64(stats.Misses), name)
+		ch <- prometheus.MustNewConstMetric(c.timeouts, prometheus.CounterValue, float64(stats.Timeouts), name)
+		ch <- prometheus.MustNewConstMetric(c.totalConns, prometheus.GaugeValue, float64(stats.TotalConns), name)
+		ch <- prometheus.MustNewConstMetric(c.idleConns, prometheus.GaugeValue, float64(stats.IdleConns), name)
+		ch <- prometheus.MustNewConstMetric(c.staleConns, prometheus.CounterValue, float64(stats.StaleConns), name)
+	}
+}
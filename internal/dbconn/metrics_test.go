@@ -0,0 +1,26 @@
+package dbconn
+
+import (
+	"testing"
+
+	"github.com/go-pg/pg"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatsCollector_Register(t *testing.T) {
+	// Pool stats are read without connecting.
+	primary, replica := pg.Connect(&pg.Options{}), pg.Connect(&pg.Options{})
+	defer primary.Close()
+	defer replica.Close()
+
+	registry := prometheus.NewPedanticRegistry()
+	require.NoError(t, registry.Register(NewStatsCollector(map[string]*pg.DB{"primary": primary, "replica": replica})))
+
+	families, err := registry.Gather()
+	require.NoError(t, err)
+	require.Len(t, families, 6)
+	for _, f := range families {
+		require.Len(t, f.GetMetric(), 2, f.GetName())
+	}
+}
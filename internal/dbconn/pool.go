@@ -0,0 +1,179 @@
+package dbconn
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-pg/pg"
+	"github.com/go-pg/pg/orm"
+	"github.com/insolar/insolar/insolar"
+	"github.com/pkg/errors"
+
+	"github.com/insolar/observer/configuration"
+)
+
+// defaultCheckInterval is used if CheckInterval of replicas isn't set.
+const defaultCheckInterval = 10 * time.Second
+
+// Pool routes writes to the primary db and reads to replicas which aren't too far behind the primary.
+// Reads fall back to the primary if there are no healthy replicas.
+type Pool struct {
+	primary  *pg.DB
+	replicas []*replica
+	maxLag   int
+	interval time.Duration
+	log      insolar.Logger
+
+	next     uint32
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+type replica struct {
+	name    string
+	db      *pg.DB
+	healthy int32
+}
+
+func (r *replica) isHealthy() bool {
+	return atomic.LoadInt32(&r.healthy) == 1
+}
+
+func (r *replica) setHealthy(healthy bool) {
+	var v int32
+	if healthy {
+		v = 1
+	}
+	atomic.StoreInt32(&r.healthy, v)
+}
+
+// NewPool makes a pool without replicas, every query goes to db.
+func NewPool(db *pg.DB, log insolar.Logger) *Pool {
+	return &Pool{primary: db, log: log, stop: make(chan struct{})}
+}
+
+// ConnectPool connects to the primary db and its read replicas.
+// Replicas serve reads only after the first lag check, see Start.
+func ConnectPool(cfg configuration.DB, replicas configuration.Replicas, log insolar.Logger) (*Pool, error) {
+	primary, err := Connect(cfg)
+	if err != nil {
+		return nil, err
+	}
+	p := NewPool(primary, log)
+	p.maxLag = replicas.MaxLag
+	p.interval = replicas.CheckInterval
+	if p.interval <= 0 {
+		p.interval = defaultCheckInterval
+	}
+
+	for i, url := range replicas.URLs {
+		replicaCfg := cfg
+		replicaCfg.URL = url
+		db, err := Connect(replicaCfg)
+		if err != nil {
+			// Connect doesn't print url because of password, so replica is identified by its number.
+			return nil, errors.Wrapf(err, "replica #%d", i)
+		}
+		p.replicas = append(p.replicas, &replica{name: db.Options().Addr, db: db})
+	}
+	return p, nil
+}
+
+// Primary returns the db for writes and reads which must see the latest data.
+func (p *Pool) Primary() *pg.DB {
+	return p.primary
+}
+
+// Read returns a healthy replica in round-robin manner or the primary if there are none.
+func (p *Pool) Read() *pg.DB {
+	n := len(p.replicas)
+	if n == 0 {
+		return p.primary
+	}
+	start := atomic.AddUint32(&p.next, 1)
+	for i := 0; i < n; i++ {
+		r := p.replicas[(int(start)+i)%n]
+		if r.isHealthy() {
+			return r.db
+		}
+	}
+	return p.primary
+}
+
+// StatsCollector exports stats of the primary and replicas connection pools.
+func (p *Pool) StatsCollector() *StatsCollector {
+	dbs := map[string]*pg.DB{"primary": p.primary}
+	for _, r := range p.replicas {
+		dbs[r.name] = r.db
+	}
+	return NewStatsCollector(dbs)
+}
+
+// Start checks replicas lag and keeps checking it in background until Stop.
+func (p *Pool) Start() {
+	if len(p.replicas) == 0 {
+		return
+	}
+	p.check()
+	go func() {
+		ticker := time.NewTicker(p.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-p.stop:
+				return
+			case <-ticker.C:
+				p.check()
+			}
+		}
+	}()
+}
+
+func (p *Pool) Stop() {
+	p.stopOnce.Do(func() {
+		close(p.stop)
+	})
+}
+
+// Close stops lag checks and closes all connections.
+func (p *Pool) Close() error {
+	p.Stop()
+	for _, r := range p.replicas {
+		if err := r.db.Close(); err != nil {
+			p.log.Error(errors.Wrapf(err, "failed to close replica %s", r.name))
+		}
+	}
+	return p.primary.Close()
+}
+
+func (p *Pool) check() {
+	for _, r := range p.replicas {
+		lag, err := replicaLag(p.primary, r.db)
+		if err != nil {
+			p.log.Error(errors.Wrapf(err, "failed to check replica %s", r.name))
+			r.setHealthy(false)
+			continue
+		}
+		healthy := lag <= p.maxLag
+		if healthy != r.isHealthy() {
+			p.log.Infof("replica %s lag is %d pulses, healthy: %t", r.name, lag, healthy)
+		}
+		r.setHealthy(healthy)
+	}
+}
+
+// replicaLag returns how many pulses the primary has after the last pulse of the replica.
+func replicaLag(primary, replica orm.DB) (int, error) {
+	var last int64
+	_, err := replica.QueryOne(pg.Scan(&last), "SELECT coalesce(max(pulse), 0) FROM pulses")
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get last pulse of replica")
+	}
+	var lag int
+	_, err = primary.QueryOne(pg.Scan(&lag), "SELECT count(*) FROM pulses WHERE pulse > ?", last)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to count pulses on primary")
+	}
+	return lag, nil
+}
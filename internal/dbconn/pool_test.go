@@ -0,0 +1,41 @@
+package dbconn
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-pg/pg"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPool_Read(t *testing.T) {
+	log := inslogger.FromContext(context.Background())
+	primary := pg.Connect(&pg.Options{Addr: "primary:5432"})
+	first := &replica{name: "first", db: pg.Connect(&pg.Options{Addr: "first:5432"})}
+	second := &replica{name: "second", db: pg.Connect(&pg.Options{Addr: "second:5432"})}
+
+	p := NewPool(primary, log)
+	require.Equal(t, primary, p.Read())
+	require.Equal(t, primary, p.Primary())
+
+	p.replicas = []*replica{first, second}
+	require.Equal(t, primary, p.Read(), "replicas aren't checked yet")
+
+	first.setHealthy(true)
+	for i := 0; i < 3; i++ {
+		require.Equal(t, first.db, p.Read())
+	}
+
+	second.setHealthy(true)
+	seen := map[*pg.DB]bool{}
+	for i := 0; i < 4; i++ {
+		seen[p.Read()] = true
+	}
+	require.Len(t, seen, 2)
+	require.False(t, seen[primary])
+
+	first.setHealthy(false)
+	second.setHealthy(false)
+	require.Equal(t, primary, p.Read())
+}
@@ -0,0 +1,227 @@
+package dbmigrate
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// NoTransactionMarker disables wrapping of a migration script into a transaction.
+// Required for statements postgres can't run in a transaction block (e.g. "alter type ... add value").
+// Such scripts run statement by statement, so they should be safe to rerun after a partial failure.
+const NoTransactionMarker = "-- observer:no-transaction"
+
+// Migration is a pair of sql scripts sharing the same version.
+// Files are named in go-pg style: <version>_<name>.up.sql and <version>_<name>.down.sql.
+type Migration struct {
+	Version int64
+	Name    string
+
+	UpFile   string
+	DownFile string
+}
+
+func (m Migration) String() string {
+	return fmt.Sprintf("%d_%s", m.Version, m.Name)
+}
+
+// Script reads up or down sql of the migration and reports if it must run in a transaction.
+func (m Migration) Script(up bool) (sql string, tx bool, err error) {
+	file := m.UpFile
+	if !up {
+		file = m.DownFile
+	}
+	if file == "" {
+		return "", false, fmt.Errorf("migration %s has no down script", m)
+	}
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return "", false, errors.Wrapf(err, "failed to read %s", file)
+	}
+	return string(data), !hasNoTransactionMarker(data), nil
+}
+
+func hasNoTransactionMarker(data []byte) bool {
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		if strings.TrimSpace(scanner.Text()) == NoTransactionMarker {
+			return true
+		}
+	}
+	return false
+}
+
+// Statements splits the script by lines ending with ";", statements that are only comments are dropped.
+// Postgres runs several statements of one query in a transaction, so scripts without it are run one by one.
+func Statements(sql string) []string {
+	var (
+		res  []string
+		stmt strings.Builder
+		code bool
+	)
+	scanner := bufio.NewScanner(strings.NewReader(sql))
+	for scanner.Scan() {
+		line := scanner.Text()
+		trimmed := strings.TrimSpace(line)
+		stmt.WriteString(line)
+		stmt.WriteString("\n")
+		if trimmed != "" && !strings.HasPrefix(trimmed, "--") {
+			code = true
+		}
+		if strings.HasSuffix(trimmed, ";") {
+			if code {
+				res = append(res, stmt.String())
+			}
+			stmt.Reset()
+			code = false
+		}
+	}
+	if code {
+		res = append(res, stmt.String())
+	}
+	return res
+}
+
+// Discover collects migrations from dir ordered by version.
+func Discover(dir string) ([]Migration, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read migrations dir %s", dir)
+	}
+
+	byVersion := map[int64]*Migration{}
+	for _, f := range files {
+		if f.IsDir() {
+			continue
+		}
+		name := f.Name()
+		var up bool
+		switch {
+		case strings.HasSuffix(name, ".up.sql"):
+			up = true
+			name = strings.TrimSuffix(name, ".up.sql")
+		case strings.HasSuffix(name, ".down.sql"):
+			name = strings.TrimSuffix(name, ".down.sql")
+		default:
+			continue
+		}
+
+		idx := strings.IndexByte(name, '_')
+		if idx == -1 {
+			return nil, fmt.Errorf("file %s must have name in format <version>_<name>", f.Name())
+		}
+		version, err := strconv.ParseInt(name[:idx], 10, 64)
+		if err != nil || version <= 0 {
+			return nil, fmt.Errorf("file %s has invalid version", f.Name())
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: name[idx+1:]}
+			byVersion[version] = m
+		}
+		if m.Name != name[idx+1:] {
+			return nil, fmt.Errorf("migration %d has different names: %s and %s", version, m.Name, name[idx+1:])
+		}
+
+		path := filepath.Join(dir, f.Name())
+		if up {
+			m.UpFile = path
+		} else {
+			m.DownFile = path
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.UpFile == "" {
+			return nil, fmt.Errorf("migration %s has no up script", m)
+		}
+		migrations = append(migrations, *m)
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+	return migrations, nil
+}
+
+// Latest returns the highest known version or 0 if there are no migrations.
+func Latest(migrations []Migration) int64 {
+	if len(migrations) == 0 {
+		return 0
+	}
+	return migrations[len(migrations)-1].Version
+}
+
+// Step is a single migration application in a plan.
+type Step struct {
+	Migration Migration
+	Up        bool
+	// Version is written to the migrations table after the step.
+	Version int64
+}
+
+func (s Step) String() string {
+	if s.Up {
+		return fmt.Sprintf("up %s", s.Migration)
+	}
+	return fmt.Sprintf("down %s", s.Migration)
+}
+
+// Plan returns steps required to move schema from current to target version.
+func Plan(migrations []Migration, current, target int64) ([]Step, error) {
+	if target < 0 || target > Latest(migrations) {
+		return nil, fmt.Errorf("unknown target version %d, latest is %d", target, Latest(migrations))
+	}
+	if current > Latest(migrations) {
+		return nil, fmt.Errorf("db version %d is newer than latest known migration %d", current, Latest(migrations))
+	}
+
+	var steps []Step
+	if target >= current {
+		for _, m := range migrations {
+			if m.Version > current && m.Version <= target {
+				steps = append(steps, Step{Migration: m, Up: true, Version: m.Version})
+			}
+		}
+		return steps, nil
+	}
+
+	for i := len(migrations) - 1; i >= 0; i-- {
+		m := migrations[i]
+		if m.Version > current {
+			continue
+		}
+		if m.Version <= target {
+			break
+		}
+		if m.DownFile == "" {
+			return nil, fmt.Errorf("migration %s has no down script", m)
+		}
+		var prev int64
+		if i > 0 {
+			prev = migrations[i-1].Version
+		}
+		steps = append(steps, Step{Migration: m, Up: false, Version: prev})
+	}
+	return steps, nil
+}
+
+// Previous returns the version one migration below current, 0 if there is none.
+func Previous(migrations []Migration, current int64) int64 {
+	var prev int64
+	for _, m := range migrations {
+		if m.Version >= current {
+			break
+		}
+		prev = m.Version
+	}
+	return prev
+}
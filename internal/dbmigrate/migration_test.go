@@ -0,0 +1,167 @@
+package dbmigrate
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func writeFiles(t *testing.T, files map[string]string) string {
+	dir, err := ioutil.TempDir("", "dbmigrate")
+	require.NoError(t, err)
+	for name, content := range files {
+		err := ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0600)
+		require.NoError(t, err)
+	}
+	return dir
+}
+
+func TestDiscover(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"1_scheme.up.sql":     "create table a();",
+		"1_scheme.down.sql":   "drop table a;",
+		"2_enum.up.sql":       NoTransactionMarker + "\nalter type t add value 'x';",
+		"10_later.up.sql":     "create table b();",
+		"10_later.down.sql":   "drop table b;",
+		"README.md":           "not a migration",
+		"3_orphan.down.sql.b": "ignored",
+	})
+	defer os.RemoveAll(dir)
+
+	migrations, err := Discover(dir)
+	require.NoError(t, err)
+	require.Len(t, migrations, 3)
+	require.Equal(t, int64(1), migrations[0].Version)
+	require.Equal(t, "scheme", migrations[0].Name)
+	require.Equal(t, int64(2), migrations[1].Version)
+	require.Empty(t, migrations[1].DownFile)
+	require.Equal(t, int64(10), migrations[2].Version)
+	require.Equal(t, int64(10), Latest(migrations))
+
+	sql, tx, err := migrations[0].Script(false)
+	require.NoError(t, err)
+	require.Equal(t, "drop table a;", sql)
+	require.True(t, tx)
+
+	_, tx, err = migrations[1].Script(true)
+	require.NoError(t, err)
+	require.False(t, tx)
+
+	_, _, err = migrations[1].Script(false)
+	require.Error(t, err)
+}
+
+func TestStatements(t *testing.T) {
+	sql := NoTransactionMarker + `
+create index concurrently if not exists a
+    on t (a);
+
+-- the second one
+create index concurrently if not exists b on t (b);
+-- trailing comment
+`
+	require.Equal(t, []string{
+		NoTransactionMarker + "\ncreate index concurrently if not exists a\n    on t (a);\n",
+		"\n-- the second one\ncreate index concurrently if not exists b on t (b);\n",
+	}, Statements(sql))
+	require.Empty(t, Statements(NoTransactionMarker+"\n"))
+}
+
+func TestDiscover_Errors(t *testing.T) {
+	t.Run("down without up", func(t *testing.T) {
+		dir := writeFiles(t, map[string]string{"1_a.down.sql": ""})
+		defer os.RemoveAll(dir)
+		_, err := Discover(dir)
+		require.Error(t, err)
+	})
+	t.Run("bad version", func(t *testing.T) {
+		dir := writeFiles(t, map[string]string{"a_b.up.sql": ""})
+		defer os.RemoveAll(dir)
+		_, err := Discover(dir)
+		require.Error(t, err)
+	})
+	t.Run("different names", func(t *testing.T) {
+		dir := writeFiles(t, map[string]string{"1_a.up.sql": "", "1_b.down.sql": ""})
+		defer os.RemoveAll(dir)
+		_, err := Discover(dir)
+		require.Error(t, err)
+	})
+}
+
+func TestPlan(t *testing.T) {
+	migrations := []Migration{
+		{Version: 1, Name: "a", UpFile: "1_a.up.sql", DownFile: "1_a.down.sql"},
+		{Version: 2, Name: "b", UpFile: "2_b.up.sql", DownFile: "2_b.down.sql"},
+		{Version: 4, Name: "c", UpFile: "4_c.up.sql", DownFile: "4_c.down.sql"},
+	}
+
+	versions := func(steps []Step) []int64 {
+		var res []int64
+		for _, s := range steps {
+			res = append(res, s.Version)
+		}
+		return res
+	}
+
+	t.Run("up from scratch", func(t *testing.T) {
+		steps, err := Plan(migrations, 0, 4)
+		require.NoError(t, err)
+		require.Equal(t, []int64{1, 2, 4}, versions(steps))
+		for _, s := range steps {
+			require.True(t, s.Up)
+		}
+	})
+	t.Run("up partially", func(t *testing.T) {
+		steps, err := Plan(migrations, 1, 2)
+		require.NoError(t, err)
+		require.Equal(t, []int64{2}, versions(steps))
+	})
+	t.Run("nothing to do", func(t *testing.T) {
+		steps, err := Plan(migrations, 4, 4)
+		require.NoError(t, err)
+		require.Empty(t, steps)
+	})
+	t.Run("down", func(t *testing.T) {
+		steps, err := Plan(migrations, 4, 1)
+		require.NoError(t, err)
+		require.Len(t, steps, 2)
+		require.False(t, steps[0].Up)
+		require.Equal(t, int64(4), steps[0].Migration.Version)
+		require.Equal(t, []int64{2, 1}, versions(steps))
+	})
+	t.Run("down to zero", func(t *testing.T) {
+		steps, err := Plan(migrations, 2, 0)
+		require.NoError(t, err)
+		require.Equal(t, []int64{1, 0}, versions(steps))
+	})
+	t.Run("previous", func(t *testing.T) {
+		require.Equal(t, int64(2), Previous(migrations, 4))
+		require.Equal(t, int64(0), Previous(migrations, 1))
+	})
+	t.Run("unknown target", func(t *testing.T) {
+		_, err := Plan(migrations, 0, 5)
+		require.Error(t, err)
+	})
+	t.Run("db is newer", func(t *testing.T) {
+		_, err := Plan(migrations, 7, 4)
+		require.Error(t, err)
+	})
+	t.Run("no down script", func(t *testing.T) {
+		noDown := append([]Migration{}, migrations...)
+		noDown[1].DownFile = ""
+		_, err := Plan(noDown, 4, 0)
+		require.Error(t, err)
+	})
+}
+
+func TestScriptsHaveDownMigrations(t *testing.T) {
+	migrations, err := Discover("../../scripts/migrations")
+	require.NoError(t, err)
+	require.NotEmpty(t, migrations)
+	for _, m := range migrations {
+		require.NotEmpty(t, m.DownFile, "migration %s has no down script", m)
+	}
+}
@@ -0,0 +1,180 @@
+package dbmigrate
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/go-pg/pg"
+	"github.com/go-pg/pg/orm"
+	"github.com/pkg/errors"
+)
+
+// TableName is shared with go-pg migrations, so databases migrated by older builds keep their version.
+const TableName = "gopg_migrations"
+
+// lockID is a key of the postgres advisory lock held while migrations are applied.
+// Replicas starting at the same time wait for each other instead of running the same scripts twice.
+const lockID = 4242001
+
+// Status describes a known migration against the db.
+type Status struct {
+	Migration Migration
+	Applied   bool
+}
+
+// Migrator applies migrations to the db.
+type Migrator struct {
+	db         *pg.DB
+	migrations []Migration
+	// dryRun prints sql into out instead of executing it.
+	dryRun bool
+	out    io.Writer
+}
+
+func NewMigrator(db *pg.DB, migrations []Migration) *Migrator {
+	return &Migrator{
+		db:         db,
+		migrations: migrations,
+	}
+}
+
+// DryRun makes migrator print planned sql into out without touching the schema.
+func (m *Migrator) DryRun(out io.Writer) *Migrator {
+	m.dryRun = true
+	m.out = out
+	return m
+}
+
+// Init creates migrations table, it's safe to call it on already initialized db.
+func (m *Migrator) Init() error {
+	if m.dryRun {
+		return nil
+	}
+	_, err := m.db.Exec(`
+		CREATE TABLE IF NOT EXISTS ? (
+			id serial,
+			version bigint,
+			created_at timestamptz
+		)`, pg.Q(TableName))
+	return errors.Wrap(err, "failed to create migrations table")
+}
+
+// Version returns current schema version.
+func (m *Migrator) Version() (int64, error) {
+	return version(m.db)
+}
+
+// Status returns known migrations with applied flag and current schema version.
+func (m *Migrator) Status() ([]Status, int64, error) {
+	current, err := m.Version()
+	if err != nil {
+		return nil, 0, err
+	}
+	res := make([]Status, 0, len(m.migrations))
+	for _, mg := range m.migrations {
+		res = append(res, Status{Migration: mg, Applied: mg.Version <= current})
+	}
+	return res, current, nil
+}
+
+// Up applies all pending migrations.
+func (m *Migrator) Up() ([]Step, error) {
+	return m.To(Latest(m.migrations))
+}
+
+// Down reverts the last applied migration.
+func (m *Migrator) Down() ([]Step, error) {
+	return m.migrate(func(current int64) int64 {
+		return Previous(m.migrations, current)
+	})
+}
+
+// To moves schema up or down to target version.
+func (m *Migrator) To(target int64) ([]Step, error) {
+	return m.migrate(func(int64) int64 {
+		return target
+	})
+}
+
+func (m *Migrator) migrate(target func(current int64) int64) ([]Step, error) {
+	conn := m.db.Conn()
+	defer conn.Close()
+
+	if _, err := conn.Exec("SELECT pg_advisory_lock(?)", lockID); err != nil {
+		return nil, errors.Wrap(err, "failed to acquire migrations lock")
+	}
+	defer conn.Exec("SELECT pg_advisory_unlock(?)", lockID) // nolint: errcheck
+
+	// Version is read under the lock, another replica could migrate the db while we were waiting.
+	current, err := version(conn)
+	if err != nil {
+		return nil, err
+	}
+	steps, err := Plan(m.migrations, current, target(current))
+	if err != nil {
+		return nil, err
+	}
+
+	for i, s := range steps {
+		if err := m.apply(conn, s); err != nil {
+			return steps[:i], errors.Wrapf(err, "failed to apply %s", s)
+		}
+	}
+	return steps, nil
+}
+
+func (m *Migrator) apply(conn *pg.Conn, s Step) error {
+	sql, inTx, err := s.Migration.Script(s.Up)
+	if err != nil {
+		return err
+	}
+
+	if m.dryRun {
+		_, err := fmt.Fprintf(m.out, "-- %s (version %d)\n%s\n", s, s.Version, sql)
+		return err
+	}
+
+	if !inTx {
+		for _, stmt := range Statements(sql) {
+			if _, err := conn.Exec(stmt); err != nil {
+				return err
+			}
+		}
+		return setVersion(conn, s.Version)
+	}
+
+	return conn.RunInTransaction(func(tx *pg.Tx) error {
+		if _, err := tx.Exec(sql); err != nil {
+			return err
+		}
+		return setVersion(tx, s.Version)
+	})
+}
+
+func version(db orm.DB) (int64, error) {
+	var exists bool
+	_, err := db.QueryOne(pg.Scan(&exists), "SELECT to_regclass(?) IS NOT NULL", TableName)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to check migrations table")
+	}
+	// Not initialized db is treated as an empty one.
+	if !exists {
+		return 0, nil
+	}
+
+	var v int64
+	_, err = db.QueryOne(pg.Scan(&v), "SELECT version FROM ? ORDER BY id DESC LIMIT 1", pg.Q(TableName))
+	if err == pg.ErrNoRows {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get schema version")
+	}
+	return v, nil
+}
+
+func setVersion(db orm.DB, v int64) error {
+	_, err := db.Exec("INSERT INTO ? (version, created_at) VALUES (?, ?)", pg.Q(TableName), v, time.Now())
+	return errors.Wrap(err, "failed to set schema version")
+}
@@ -0,0 +1,59 @@
+package dbmigrate
+
+import (
+	"strconv"
+
+	"github.com/go-pg/pg/orm"
+	"github.com/pkg/errors"
+)
+
+// SchemaVersion is the schema version the binaries are built for, it must be equal to the latest migration.
+// Could be overridden at build time:
+// go build -ldflags "-X github.com/insolar/observer/internal/dbmigrate.SchemaVersion=13"
+var SchemaVersion = "28"
+
+var ErrSchemaMismatch = errors.New("db schema version mismatch")
+
+// Schema is a result of comparing the db schema with the expected one.
+type Schema struct {
+	Version  int64 `json:"schemaVersion"`
+	Expected int64 `json:"expectedSchemaVersion"`
+}
+
+func (s Schema) Matches() bool {
+	return s.Version == s.Expected
+}
+
+// ExpectedVersion returns SchemaVersion as a number.
+func ExpectedVersion() (int64, error) {
+	v, err := strconv.ParseInt(SchemaVersion, 10, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid embedded schema version %q", SchemaVersion)
+	}
+	return v, nil
+}
+
+// CheckSchema reads the schema version from the migrations table and compares it with the expected one.
+// ErrSchemaMismatch is returned with the filled Schema if versions are different.
+func CheckSchema(db orm.DB) (Schema, error) {
+	expected, err := ExpectedVersion()
+	if err != nil {
+		return Schema{}, err
+	}
+	current, err := version(db)
+	if err != nil {
+		return Schema{Expected: expected}, err
+	}
+
+	s := Schema{Version: current, Expected: expected}
+	if !s.Matches() {
+		if current < expected {
+			return s, errors.Wrapf(ErrSchemaMismatch,
+				"db schema version is %d, but %d is required, apply migrations with `migrate up`", current, expected)
+		}
+		return s, errors.Wrapf(ErrSchemaMismatch,
+			"db schema version is %d, but the binary is built for %d, update the binary or run `migrate to %d`",
+			current, expected, expected)
+	}
+	return s, nil
+}
@@ -0,0 +1,21 @@
+package dbmigrate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchemaVersion_MatchesScripts(t *testing.T) {
+	migrations, err := Discover("../../scripts/migrations")
+	require.NoError(t, err)
+
+	expected, err := ExpectedVersion()
+	require.NoError(t, err)
+	require.Equal(t, Latest(migrations), expected, "update SchemaVersion after adding a migration")
+}
+
+func TestSchema_Matches(t *testing.T) {
+	require.True(t, Schema{Version: 13, Expected: 13}.Matches())
+	require.False(t, Schema{Version: 12, Expected: 13}.Matches())
+}
@@ -80,6 +80,8 @@ type TxIndexType int
 const (
 	TxIndexTypePulseRecord       TxIndexType = 1
 	TxIndexTypeFinishPulseRecord TxIndexType = 2
+	// TxIndexTypeChange is the position of the last registration or status change, it is used by the stream.
+	TxIndexTypeChange TxIndexType = 3
 )
 
 type Transaction struct {
@@ -107,6 +109,12 @@ type Transaction struct {
 	StatusFinished    bool     `sql:"status_finished"`
 	FinishSuccess     bool     `sql:"finish_success"`
 	FinishPulseRecord [2]int64 `sql:"finish_pulse_record" pg:",array"`
+
+	// Set by the db on registration and status changes, it is 0 for transactions not changed since migration 27.
+	ChangeSeq int64 `sql:"change_seq"`
+
+	// Unix time of the pulse from the pulses table, it isn't a column and is set by component.SetPulseDates.
+	PulseDate int64 `sql:"-"`
 }
 
 type MigrationAddress struct {
@@ -118,12 +126,45 @@ type MigrationAddress struct {
 	Wasted    bool   `sql:"wasted,notnull"`
 }
 
+// Notification is shown from Start till Stop, the higher Priority goes first.
+// Audience "all" and empty Locale match any client.
 type Notification struct {
 	tableName struct{} `sql:"notifications"` // nolint: unused,structcheck
 
-	Message string    `sql:"message,notnull"`
-	Start   time.Time `sql:"start,notnull"`
-	Stop    time.Time `sql:"stop,notnull"`
+	ID        int64     `sql:"id,pk"`
+	Message   string    `sql:"message,notnull"`
+	Start     time.Time `sql:"start,notnull"`
+	Stop      time.Time `sql:"stop,notnull"`
+	Priority  int       `sql:"priority,notnull"`
+	Audience  string    `sql:"audience,default:'all',notnull"`
+	Locale    string    `sql:"locale,notnull"`
+	CreatedBy string    `sql:"created_by,notnull"`
+	UpdatedBy string    `sql:"updated_by,notnull"`
+	Created   time.Time `sql:"created,default:now(),notnull"`
+	Updated   time.Time `sql:"updated,default:now(),notnull"`
+}
+
+const NotificationAudienceAll = "all"
+
+type NotificationAction string
+
+const (
+	NotificationActionCreate NotificationAction = "create"
+	NotificationActionUpdate NotificationAction = "update"
+	NotificationActionDelete NotificationAction = "delete"
+)
+
+// NotificationAudit records who changed the notification, Notification is the JSON of the notification
+// after the change or before the delete.
+type NotificationAudit struct {
+	tableName struct{} `sql:"notification_audit"` // nolint: unused,structcheck
+
+	ID             int64              `sql:"id,pk"`
+	NotificationID int64              `sql:"notification_id,notnull"`
+	Action         NotificationAction `sql:"action,notnull"`
+	Actor          string             `sql:"actor,notnull"`
+	Notification   string             `sql:"notification,notnull"`
+	Created        time.Time          `sql:"created,default:now(),notnull"`
 }
 
 type Pulse struct {
@@ -133,6 +174,16 @@ type Pulse struct {
 	PulseDate int64  `sql:"pulse_date"`
 	Entropy   []byte `sql:"entropy"`
 	Nodes     uint32 `sql:"nodes"`
+	Records   *int64 `sql:"records"`
+	Requests  *int64 `sql:"requests"`
+}
+
+type PulseNode struct {
+	tableName struct{} `sql:"pulse_nodes"` // nolint: unused,structcheck
+
+	Pulse     uint32 `sql:"pulse,pk"`
+	Reference []byte `sql:"node_ref,pk"`
+	Role      string `sql:"role,notnull"`
 }
 
 type NetworkStats struct {
@@ -153,6 +204,48 @@ type SupplyStats struct {
 
 	Created time.Time `sql:"created,pk,default:now(),notnull"`
 	Total   string    `sql:"total"`
+	// Total split by the state of deposits: locked is held until hold release date, vesting isn't released
+	// by the vesting schedule yet and circulating is the rest. Burned isn't a part of total.
+	// The parts are empty for stats collected before they were introduced.
+	Circulating string `sql:"circulating"`
+	Locked      string `sql:"locked"`
+	Vesting     string `sql:"vesting"`
+	Burned      string `sql:"burned"`
+}
+
+// HolderStats is a member in the top of a holders snapshot, balance is account plus deposit balance.
+type HolderStats struct {
+	tableName struct{} `sql:"holder_stats"` // nolint: unused,structcheck
+
+	Created        time.Time `sql:"created,pk"`
+	Rank           int       `sql:"rank,pk"`
+	Reference      []byte    `sql:"member_ref,notnull"`
+	AccountBalance string    `sql:"account_balance,notnull"`
+	DepositBalance string    `sql:"deposit_balance,notnull"`
+	Balance        string    `sql:"balance,notnull"`
+}
+
+// DistributionStats describes how balances of holders are spread in a snapshot. Shares are parts of total
+// held by the top 10 and top 100 holders.
+type DistributionStats struct {
+	tableName struct{} `sql:"distribution_stats"` // nolint: unused,structcheck
+
+	Created     time.Time `sql:"created,pk"`
+	Holders     int64     `sql:"holders,notnull"`
+	Total       string    `sql:"total,notnull"`
+	Gini        float64   `sql:"gini,notnull"`
+	Top10Share  float64   `sql:"top10_share,notnull"`
+	Top100Share float64   `sql:"top100_share,notnull"`
+}
+
+// DistributionBucket counts holders with balance from LowerBound up to the next bucket.
+type DistributionBucket struct {
+	tableName struct{} `sql:"distribution_buckets"` // nolint: unused,structcheck
+
+	Created    time.Time `sql:"created,pk"`
+	LowerBound string    `sql:"lower_bound,pk"`
+	Holders    int64     `sql:"holders,notnull"`
+	Balance    string    `sql:"balance,notnull"`
 }
 
 type BinanceStats struct {
@@ -293,13 +386,19 @@ func (t *Transaction) Index(indexType TxIndexType) string {
 	switch indexType {
 	case TxIndexTypeFinishPulseRecord:
 		result = fmt.Sprintf("%d:%d", t.FinishPulseRecord[0], t.FinishPulseRecord[1])
+	case TxIndexTypeChange:
+		result = fmt.Sprintf("%d", t.ChangeSeq)
 	default: // TxIndexTypePulseRecord
 		result = fmt.Sprintf("%d:%d", t.PulseRecord[0], t.PulseRecord[1])
 	}
 	return result
 }
 
+// Timestamp is PulseDate if it is set and the approximate time of the pulse number otherwise.
 func (t *Transaction) Timestamp() int64 {
+	if t.PulseDate != 0 {
+		return t.PulseDate
+	}
 	p := t.PulseNumber()
 	pulseTime, err := pulse.Number(p).AsApproximateTime()
 	if err != nil {
@@ -309,12 +408,12 @@ func (t *Transaction) Timestamp() int64 {
 }
 
 func (s *SupplyStats) TotalInXNS() string {
-	return convertCoinsToXNS(s.Total)
+	return ConvertCoinsToXNS(s.Total)
 }
 
-// convertCoinsToXNS places decimal point correctly into string to convert
+// ConvertCoinsToXNS places decimal point correctly into string to convert
 // from coins to XNS
-func convertCoinsToXNS(str string) string {
+func ConvertCoinsToXNS(str string) string {
 	l := len(str)
 
 	switch {
@@ -334,3 +433,65 @@ type BurnedBalance struct {
 	Balance      string `sql:"balance"`
 	AccountState []byte `sql:"account_state"`
 }
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliveryDelivered WebhookDeliveryStatus = "delivered"
+	WebhookDeliveryDead      WebhookDeliveryStatus = "dead"
+)
+
+// Fee is a tier of the fee schedule, tiers with the same EffectiveFrom make the schedule.
+// Empty FinSum means the tier has no upper bound.
+type Fee struct {
+	tableName struct{} `sql:"fees"` // nolint: unused,structcheck
+
+	ID            int64     `sql:"id,pk"`
+	StartSum      string    `sql:"start_sum,notnull"`
+	FinSum        string    `sql:"fin_sum"`
+	Percent       string    `sql:"percent,notnull"`
+	MinAmount     string    `sql:"min_amount,notnull"`
+	EffectiveFrom time.Time `sql:"effective_from,notnull"`
+}
+
+type Webhook struct {
+	tableName struct{} `sql:"webhooks"` // nolint: unused,structcheck
+
+	ID             int64     `sql:"id,pk"`
+	URL            string    `sql:"url,notnull"`
+	Secret         string    `sql:"secret,notnull"`
+	MemberRefs     [][]byte  `sql:"member_refs,array"`
+	Types          []string  `sql:"types,array"`
+	Statuses       []string  `sql:"statuses,array"`
+	BalanceChanges bool      `sql:"balance_changes,notnull"`
+	Created        time.Time `sql:"created,default:now(),notnull"`
+}
+
+type WebhookDelivery struct {
+	tableName struct{} `sql:"webhook_deliveries"` // nolint: unused,structcheck
+
+	ID          int64                 `sql:"id,pk"`
+	WebhookID   int64                 `sql:"webhook_id,notnull"`
+	Event       string                `sql:"event,notnull"`
+	EventKey    string                `sql:"event_key,notnull"`
+	Payload     string                `sql:"payload,notnull"`
+	Status      WebhookDeliveryStatus `sql:"status,notnull"`
+	Attempts    int                   `sql:"attempts,notnull"`
+	NextAttempt time.Time             `sql:"next_attempt,notnull"`
+	LastError   string                `sql:"last_error"`
+	Created     time.Time             `sql:"created,default:now(),notnull"`
+	Delivered   *time.Time            `sql:"delivered"`
+}
+
+type APIKey struct {
+	tableName struct{} `sql:"api_keys"` // nolint: unused,structcheck
+
+	ID      int64      `sql:"id,pk"`
+	Name    string     `sql:"name,notnull"`
+	KeyHash []byte     `sql:"key_hash,notnull"`
+	Scopes  []string   `sql:"scopes,array"`
+	Created time.Time  `sql:"created,default:now(),notnull"`
+	Expires *time.Time `sql:"expires"`
+	Revoked *time.Time `sql:"revoked"`
+}
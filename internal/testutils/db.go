@@ -7,9 +7,10 @@ import (
 
 	"github.com/stretchr/testify/require"
 
-	"github.com/go-pg/migrations"
 	"github.com/go-pg/pg"
 	"github.com/ory/dockertest/v3"
+
+	"github.com/insolar/observer/internal/dbmigrate"
 )
 
 var pgOptions = &pg.Options{
@@ -73,21 +74,20 @@ func SetupDB(migrationsDir string) (*pg.DB, pg.Options, func()) {
 		poolCleaner()
 	}
 
-	migrationCollection := migrations.NewCollection()
-
-	_, _, err = migrationCollection.Run(db, "init")
+	migrations, err := dbmigrate.Discover(migrationsDir)
 	if err != nil {
 		cleaner()
-		log.Panicf("Could not init migrations: %s", err)
+		log.Panicf("Failed to read migrations: %s", err)
 	}
 
-	err = migrationCollection.DiscoverSQLMigrations(migrationsDir)
+	migrator := dbmigrate.NewMigrator(db, migrations)
+	err = migrator.Init()
 	if err != nil {
 		cleaner()
-		log.Panicf("Failed to read migrations: %s", err)
+		log.Panicf("Could not init migrations: %s", err)
 	}
 
-	_, _, err = migrationCollection.Run(db, "up")
+	_, err = migrator.Up()
 	if err != nil {
 		cleaner()
 		log.Panicf("Could not migrate: %s", err)
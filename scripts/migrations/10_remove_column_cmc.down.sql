@@ -0,0 +1,3 @@
+-- Insert-only trigger on coin_market_cap_stats rejects updates, so the column comes back with a default.
+ALTER TABLE coin_market_cap_stats
+    ADD COLUMN IF NOT EXISTS circulating_supply numeric not null default 0;
@@ -0,0 +1 @@
+drop table if exists burned_balance;
@@ -1 +1,2 @@
+-- observer:no-transaction
 alter type transaction_type add value 'burn';
@@ -0,0 +1 @@
+drop table if exists augmented_addresses;
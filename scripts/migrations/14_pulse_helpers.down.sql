@@ -0,0 +1,3 @@
+drop function if exists string_pulse(varchar);
+
+drop function if exists bytea_pulse(bytea);
@@ -0,0 +1,10 @@
+-- Pulse number of a record id or a reference, first 4 bytes are pulse with 2 high bits of scope.
+create or replace function bytea_pulse(ref bytea) returns bigint as $$
+select ((get_byte(ref, 0)::bigint << 24) | (get_byte(ref, 1) << 16) | (get_byte(ref, 2) << 8) | get_byte(ref, 3)) & 1073741823;
+$$ language sql immutable strict;
+
+-- Same for string form of record id or reference, e.g. insolar:1AAEAAQ...
+-- First 8 base64 chars after "insolar:1" are enough to decode 4 bytes.
+create or replace function string_pulse(ref varchar) returns bigint as $$
+select bytea_pulse(decode(translate(substring(ref from 10 for 8), '-_', '+/'), 'base64'));
+$$ language sql immutable strict;
@@ -0,0 +1,3 @@
+drop trigger if exists simple_transactions_notify on simple_transactions;
+
+drop function if exists notify_transaction();
@@ -0,0 +1,17 @@
+-- Notifies API stream about new transactions and their status changes, payload is hex encoded tx_id.
+create or replace function notify_transaction() returns trigger as $$
+begin
+    if tg_op = 'UPDATE'
+        and old.status_registered is not distinct from new.status_registered
+        and old.status_sent is not distinct from new.status_sent
+        and old.status_finished is not distinct from new.status_finished then
+        return null;
+    end if;
+    perform pg_notify('observer_transactions', encode(new.tx_id, 'hex'));
+    return null;
+end;
+$$ language plpgsql;
+
+create trigger simple_transactions_notify
+    after insert or update on simple_transactions
+    for each row execute procedure notify_transaction();
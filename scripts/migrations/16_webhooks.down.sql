@@ -0,0 +1,7 @@
+drop trigger if exists members_balance_notify on members;
+
+drop function if exists notify_member_balance();
+
+drop table if exists webhook_deliveries;
+
+drop table if exists webhooks;
@@ -0,0 +1,54 @@
+create table if not exists webhooks
+(
+    id bigint generated by default as identity
+        constraint webhooks_pkey
+            primary key,
+    url varchar(2048) not null,
+    secret varchar(256) not null,
+    member_refs bytea[] not null default '{}',
+    types varchar(256)[] not null default '{}',
+    statuses varchar(256)[] not null default '{}',
+    balance_changes boolean not null default false,
+    created timestamp with time zone not null default now()
+);
+
+create table if not exists webhook_deliveries
+(
+    id bigint generated by default as identity
+        constraint webhook_deliveries_pkey
+            primary key,
+    webhook_id bigint not null
+        constraint webhook_deliveries_webhook_id_fkey
+            references webhooks
+            on delete cascade,
+    event varchar(256) not null,
+    -- Deduplicates events enqueued by several API instances.
+    event_key varchar(512) not null,
+    payload text not null,
+    status varchar(256) not null default 'pending', -- pending|delivered|dead
+    attempts int not null default 0,
+    next_attempt timestamp with time zone not null default now(),
+    last_error text,
+    created timestamp with time zone not null default now(),
+    delivered timestamp with time zone,
+    constraint webhook_deliveries_event_key
+        unique (webhook_id, event_key)
+);
+
+create index if not exists idx_webhook_deliveries_pending
+    on webhook_deliveries (next_attempt) where status = 'pending';
+
+-- Notifies API webhooks about member balance changes, payload is hex encoded member_ref.
+create or replace function notify_member_balance() returns trigger as $$
+begin
+    if tg_op = 'UPDATE' and old.balance is not distinct from new.balance then
+        return null;
+    end if;
+    perform pg_notify('observer_balances', encode(new.member_ref, 'hex'));
+    return null;
+end;
+$$ language plpgsql;
+
+create trigger members_balance_notify
+    after insert or update on members
+    for each row execute procedure notify_member_balance();
@@ -0,0 +1,15 @@
+create table if not exists api_keys
+(
+    id bigint generated by default as identity
+        constraint api_keys_pkey
+            primary key,
+    name varchar(256) not null,
+    -- sha256 of the key, the key itself is shown once when minted.
+    key_hash bytea not null
+        constraint api_keys_key_hash_key
+            unique,
+    scopes varchar(256)[] not null default '{}',
+    created timestamp with time zone not null default now(),
+    expires timestamp with time zone,
+    revoked timestamp with time zone
+);
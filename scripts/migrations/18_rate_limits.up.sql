@@ -0,0 +1,10 @@
+-- Token buckets shared between API instances, losing them on crash is fine.
+create unlogged table if not exists rate_limits
+(
+    bucket varchar(512) not null
+        constraint rate_limits_pkey
+            primary key,
+    tokens double precision not null,
+    allowed boolean not null,
+    updated timestamp with time zone not null
+);
@@ -0,0 +1,6 @@
+-- observer:no-transaction
+drop index concurrently if exists idx_simple_transactions_deposit_from_ref;
+
+drop index concurrently if exists idx_simple_transactions_deposit_to_ref;
+
+drop index concurrently if exists idx_deposits_eth_hash;
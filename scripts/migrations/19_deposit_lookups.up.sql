@@ -0,0 +1,9 @@
+-- observer:no-transaction
+create index concurrently if not exists idx_deposits_eth_hash
+    on deposits (eth_hash);
+
+create index concurrently if not exists idx_simple_transactions_deposit_to_ref
+    on simple_transactions (deposit_to_ref);
+
+create index concurrently if not exists idx_simple_transactions_deposit_from_ref
+    on simple_transactions (deposit_from_ref);
@@ -0,0 +1,18 @@
+drop function if exists debug_reference(bytea);
+
+drop table if exists notifications;
+drop table if exists supply_stats;
+drop table if exists network_stats;
+drop table if exists transactions;
+drop table if exists deposits;
+drop type if exists deposit_status;
+drop table if exists members;
+drop table if exists migration_addresses;
+drop table if exists pulses;
+drop table if exists fees;
+drop table if exists results;
+drop table if exists requests;
+drop table if exists objects;
+drop table if exists raw_side_effects;
+drop table if exists raw_results;
+drop table if exists raw_requests;
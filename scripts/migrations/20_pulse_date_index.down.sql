@@ -0,0 +1 @@
+drop index if exists idx_pulses_pulse_date;
@@ -0,0 +1,2 @@
+create index if not exists idx_pulses_pulse_date
+    on pulses (pulse_date);
@@ -0,0 +1,3 @@
+drop index if exists idx_fees_effective_from;
+
+alter table fees drop column if exists effective_from;
@@ -0,0 +1,5 @@
+-- Tiers with the same effective_from make a fee schedule, the latest one that is already effective is used.
+alter table fees add column if not exists effective_from timestamp with time zone not null default to_timestamp(0);
+
+create index if not exists idx_fees_effective_from
+    on fees (effective_from);
@@ -0,0 +1,13 @@
+drop table if exists notification_audit;
+
+drop index if exists idx_notifications_stop;
+
+alter table notifications
+    drop column if exists updated,
+    drop column if exists created,
+    drop column if exists updated_by,
+    drop column if exists created_by,
+    drop column if exists locale,
+    drop column if exists audience,
+    drop column if exists priority,
+    drop column if exists id;
@@ -0,0 +1,29 @@
+alter table notifications
+    add column if not exists id bigint generated by default as identity
+        constraint notifications_pkey primary key,
+    add column if not exists priority int not null default 0,
+    add column if not exists audience text not null default 'all',
+    add column if not exists locale text not null default '',
+    add column if not exists created_by text not null default '',
+    add column if not exists updated_by text not null default '',
+    add column if not exists created timestamp with time zone not null default now(),
+    add column if not exists updated timestamp with time zone not null default now();
+
+create index if not exists idx_notifications_stop
+    on notifications (stop);
+
+-- Every change of a notification with its state after the change, or before it for deletes.
+create table if not exists notification_audit
+(
+    id              bigint generated by default as identity
+        constraint notification_audit_pkey
+            primary key,
+    notification_id bigint not null,
+    action          text not null,
+    actor           text not null,
+    notification    jsonb not null,
+    created         timestamp with time zone not null default now()
+);
+
+create index if not exists idx_notification_audit_notification_id
+    on notification_audit (notification_id, id);
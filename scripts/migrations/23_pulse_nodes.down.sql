@@ -0,0 +1,5 @@
+drop table if exists pulse_nodes;
+
+alter table pulses
+    drop column if exists requests,
+    drop column if exists records;
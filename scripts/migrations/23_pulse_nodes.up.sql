@@ -0,0 +1,13 @@
+-- Counted when the pulse is stored, empty for pulses stored before.
+alter table pulses
+    add column if not exists records bigint,
+    add column if not exists requests bigint;
+
+create table if not exists pulse_nodes
+(
+    pulse    bigint not null,
+    node_ref bytea  not null,
+    role     text   not null,
+    constraint pulse_nodes_pkey
+        primary key (pulse, node_ref)
+);
@@ -0,0 +1,5 @@
+alter table supply_stats
+    drop column if exists circulating,
+    drop column if exists locked,
+    drop column if exists vesting,
+    drop column if exists burned;
@@ -0,0 +1,6 @@
+-- Supply split by the state of deposits, empty for stats collected before.
+alter table supply_stats
+    add column if not exists circulating numeric(24),
+    add column if not exists locked numeric(24),
+    add column if not exists vesting numeric(24),
+    add column if not exists burned numeric(24);
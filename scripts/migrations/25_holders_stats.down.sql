@@ -0,0 +1,3 @@
+drop table if exists distribution_buckets;
+drop table if exists distribution_stats;
+drop table if exists holder_stats;
@@ -0,0 +1,34 @@
+-- Snapshots of the biggest holders and the balance distribution, collected by the stats collector.
+create table if not exists holder_stats
+(
+    created         timestamp   not null,
+    rank            int         not null,
+    member_ref      bytea       not null,
+    account_balance numeric(24) not null,
+    deposit_balance numeric(24) not null,
+    balance         numeric(24) not null,
+    constraint holder_stats_pkey
+        primary key (created, rank)
+);
+
+create table if not exists distribution_stats
+(
+    created      timestamp        not null
+        constraint distribution_stats_pkey
+            primary key,
+    holders      bigint           not null,
+    total        numeric(24)      not null,
+    gini         double precision not null,
+    top10_share  double precision not null,
+    top100_share double precision not null
+);
+
+create table if not exists distribution_buckets
+(
+    created     timestamp   not null,
+    lower_bound numeric(24) not null,
+    holders     bigint      not null,
+    balance     numeric(24) not null,
+    constraint distribution_buckets_pkey
+        primary key (created, lower_bound)
+);
@@ -0,0 +1,3 @@
+-- observer:no-transaction
+drop index concurrently if exists idx_objects_prev_state;
+drop index concurrently if exists idx_objects_request;
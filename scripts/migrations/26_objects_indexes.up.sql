@@ -0,0 +1,6 @@
+-- observer:no-transaction
+create index concurrently if not exists idx_objects_request
+    on objects (request);
+
+create index concurrently if not exists idx_objects_prev_state
+    on objects (prev_state);
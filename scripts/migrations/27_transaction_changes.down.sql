@@ -0,0 +1,7 @@
+drop trigger if exists simple_transactions_change on simple_transactions;
+
+drop function if exists set_transaction_change();
+
+alter table simple_transactions drop column if exists change_seq;
+
+drop sequence if exists simple_transactions_change_seq;
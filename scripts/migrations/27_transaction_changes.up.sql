@@ -0,0 +1,25 @@
+-- Position of the last registration or status change of a transaction, the API stream resumes by it.
+create sequence if not exists simple_transactions_change_seq;
+
+alter table simple_transactions add column if not exists change_seq bigint;
+
+create or replace function set_transaction_change() returns trigger as $$
+begin
+    if tg_op = 'UPDATE'
+        and old.status_registered is not distinct from new.status_registered
+        and old.status_sent is not distinct from new.status_sent
+        and old.status_finished is not distinct from new.status_finished then
+        new.change_seq := old.change_seq;
+        return new;
+    end if;
+    new.change_seq := nextval('simple_transactions_change_seq');
+    return new;
+end;
+$$ language plpgsql;
+
+create trigger simple_transactions_change
+    before insert or update on simple_transactions
+    for each row execute procedure set_transaction_change();
+
+create index if not exists idx_simple_transactions_change_seq
+    on simple_transactions (change_seq);
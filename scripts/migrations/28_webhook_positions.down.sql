@@ -0,0 +1,9 @@
+drop table if exists webhook_positions;
+
+drop trigger if exists members_balance_change on members;
+
+drop function if exists set_member_balance_change();
+
+alter table members drop column if exists balance_seq;
+
+drop sequence if exists members_balance_seq;
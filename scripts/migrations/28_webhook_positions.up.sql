@@ -0,0 +1,38 @@
+-- Position of the last balance change of a member, webhooks catch up on changes by it.
+create sequence if not exists members_balance_seq;
+
+alter table members add column if not exists balance_seq bigint;
+
+create or replace function set_member_balance_change() returns trigger as $$
+begin
+    if tg_op = 'UPDATE' and old.balance is not distinct from new.balance then
+        new.balance_seq := old.balance_seq;
+        return new;
+    end if;
+    new.balance_seq := nextval('members_balance_seq');
+    return new;
+end;
+$$ language plpgsql;
+
+create trigger members_balance_change
+    before insert or update on members
+    for each row execute procedure set_member_balance_change();
+
+create index if not exists idx_members_balance_seq
+    on members (balance_seq);
+
+-- Last change of every source that webhooks enqueued events for, notifications sent while no API instance
+-- listened are found from it.
+create table if not exists webhook_positions
+(
+    source varchar(256) not null
+        constraint webhook_positions_pkey
+            primary key,
+    position bigint not null
+);
+
+insert into webhook_positions (source, position)
+select 'transactions', coalesce(max(change_seq), 0) from simple_transactions
+union all
+select 'balances', coalesce(max(balance_seq), 0) from members
+on conflict do nothing;
@@ -0,0 +1,3 @@
+drop table if exists simple_transactions;
+
+drop type if exists transaction_type;
@@ -0,0 +1,3 @@
+drop index if exists idx_deposits_per_state;
+
+drop index if exists idx_deposits_per_member;
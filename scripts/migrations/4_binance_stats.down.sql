@@ -0,0 +1 @@
+drop table if exists binance_stats;
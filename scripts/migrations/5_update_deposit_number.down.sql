@@ -0,0 +1 @@
+-- Data backfill of deposits.deposit_number, nothing to revert.
@@ -0,0 +1 @@
+drop index if exists idx_members_account_state;
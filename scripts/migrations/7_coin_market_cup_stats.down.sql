@@ -0,0 +1 @@
+drop table if exists coin_market_cap_stats;
@@ -0,0 +1,16 @@
+-- Binance
+drop trigger if exists binance_stats_ins_only on binance_stats;
+drop function if exists binance_stats_insert_only();
+drop trigger if exists binance_stats_aggregate_trigger on binance_stats;
+drop function if exists update_binance_stats_aggregate();
+drop table if exists binance_stats_aggregate;
+
+ALTER TABLE binance_stats
+    ALTER COLUMN symbol_price_usd TYPE TEXT USING cast(symbol_price_usd as TEXT);
+
+-- CMC
+drop trigger if exists coin_market_cap_stats_ins_only on coin_market_cap_stats;
+drop function if exists coin_market_cap_stats_insert_only();
+drop trigger if exists coin_market_cap_stats_aggregate_trigger on coin_market_cap_stats;
+drop function if exists update_coin_market_cap_stats_aggregate();
+drop table if exists coin_market_cap_stats_aggregate;
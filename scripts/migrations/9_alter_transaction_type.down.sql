@@ -0,0 +1,10 @@
+-- Enum values can't be dropped, so the type is recreated without 'allocation'.
+-- Fails if there are allocation transactions left in simple_transactions.
+alter type transaction_type rename to transaction_type_old;
+
+create type transaction_type as enum ('transfer', 'migration', 'release');
+
+alter table simple_transactions
+    alter column type type transaction_type using type::text::transaction_type;
+
+drop type transaction_type_old;
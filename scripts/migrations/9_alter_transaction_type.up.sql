@@ -1 +1,2 @@
-alter type transaction_type add value 'allocation';
\ No newline at end of file
+-- observer:no-transaction
+alter type transaction_type add value 'allocation';